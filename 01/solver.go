@@ -0,0 +1,59 @@
+package day01
+
+import (
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/slices"
+)
+
+func init() {
+	util.Register(1, "", func() util.Solver { return &Solver{} })
+}
+
+type Solver struct {
+	topThree []int
+}
+
+func (s *Solver) addTotal(total int) {
+	s.topThree = append(s.topThree, total)
+	sort.Sort(sort.Reverse(sort.IntSlice(s.topThree)))
+	if len(s.topThree) > 3 {
+		s.topThree = s.topThree[:3]
+	}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	s.topThree = make([]int, 0, 4)
+	total := 0
+	err := util.Scan(r, func(lineNo int, line string) error {
+		if len(line) == 0 {
+			s.addTotal(total)
+			total = 0
+			return nil
+		}
+
+		n, err := strconv.Atoi(line)
+		if err != nil {
+			return &util.ParseError{Line: lineNo, Column: 1, Text: line, Err: err}
+		}
+		total += n
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.addTotal(total)
+	return nil
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	return util.Int(s.topThree[0]), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	return util.Int(slices.Sum(s.topThree)), nil
+}
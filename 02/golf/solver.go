@@ -1,22 +1,28 @@
-package main
+package golf
 
 import (
-	"flag"
-	"fmt"
+	"io"
 
 	"github.com/martin-nyaga/aoc-2022/util"
 )
 
-func readInput() []byte {
-	return util.NewInputFile("2").ReadBytes()
+func init() {
+	util.Register(2, "golf", func() util.Solver { return &Solver{} })
 }
 
-func main() {
-	flag.Parse()
+type Solver struct {
+	z1, z2 int
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	input, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
 
 	var z1, z2 int
 	var t, m1, m2 byte
-	for i, x := range readInput() {
+	for i, x := range input {
 		switch i % 4 {
 		case 0:
 			t = x - 65
@@ -41,6 +47,9 @@ func main() {
 		}
 	}
 
-	fmt.Println("Part 1:", z1)
-	fmt.Println("Part 2:", z2)
+	s.z1, s.z2 = z1, z2
+	return nil
 }
+
+func (s *Solver) Part1() (util.Answer, error) { return util.Int(s.z1), nil }
+func (s *Solver) Part2() (util.Answer, error) { return util.Int(s.z2), nil }
@@ -0,0 +1,115 @@
+package day02
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/parse"
+)
+
+func init() {
+	util.Register(2, "", func() util.Solver { return &Solver{} })
+}
+
+const (
+	Rock int = iota
+	Paper
+	Scissors
+)
+
+const (
+	_ int = iota
+	Win
+	Lose
+	Draw
+)
+
+var Theirs = map[string]int{
+	"A": Rock,
+	"B": Paper,
+	"C": Scissors,
+}
+
+var Mine = map[string]int{
+	"X": Rock,
+	"Y": Paper,
+	"Z": Scissors,
+}
+
+var Endings = map[string]int{
+	"X": Lose,
+	"Y": Draw,
+	"Z": Win,
+}
+
+type Round [2]int
+
+func (r Round) Score() int {
+	mine := r[1]
+	theirs := r[0]
+
+	diff := ((theirs-mine)%3 + 3) % 3
+
+	score := mine + 1
+
+	if diff == 2 {
+		score += 6
+	} else if diff == 0 {
+		score += 3
+	}
+
+	return score
+}
+
+func (r Round) ScoreRigged() int {
+	theirs := r[0]
+	mine := (theirs + r[1]) % 3
+	return Round{theirs, mine}.Score()
+}
+
+func parseRound(lineNo int, line string, theirMap, myMap map[string]int) (Round, error) {
+	var theirMove, myMove string
+	if err := parse.Match("{word} {word}", line, &theirMove, &myMove); err != nil {
+		return Round{}, parse.LineError(lineNo, line, err)
+	}
+	theirs, exists := theirMap[theirMove]
+	if !exists {
+		return Round{}, &util.ParseError{Line: lineNo, Column: 1, Text: line, Err: fmt.Errorf("Unknown move %q", theirMove)}
+	}
+	mine, exists := myMap[myMove]
+	if !exists {
+		return Round{}, &util.ParseError{Line: lineNo, Column: len(theirMove) + 2, Text: line, Err: fmt.Errorf("Unknown move %q", myMove)}
+	}
+	return Round{theirs, mine}, nil
+}
+
+type Solver struct {
+	total       int
+	riggedTotal int
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	return util.Scan(r, func(lineNo int, line string) error {
+		round, err := parseRound(lineNo, line, Theirs, Mine)
+		if err != nil {
+			return err
+		}
+		s.total += round.Score()
+
+		round, err = parseRound(lineNo, line, Theirs, Endings)
+		if err != nil {
+			return err
+		}
+		s.riggedTotal += round.ScoreRigged()
+		return nil
+	})
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	return util.Int(s.total), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	return util.Int(s.riggedTotal), nil
+}
@@ -0,0 +1,96 @@
+package day03
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/bitset"
+)
+
+func init() {
+	util.Register(3, "", func() util.Solver { return &Solver{} })
+}
+
+func Priority(item byte) int {
+	if item < 91 {
+		return int(item) - 65 + 27
+	} else {
+		return int(item) - 96
+	}
+}
+
+// itemSet is the set of priorities of the items in a rucksack
+func itemSet(items string) bitset.Bits64 {
+	var result bitset.Bits64
+	for _, item := range []byte(items) {
+		result.Add(Priority(item))
+	}
+	return result
+}
+
+// duplicatedItems are the priorities of the items found in both compartments
+// of a rucksack
+func duplicatedItems(line string) bitset.Bits64 {
+	half := len(line) / 2
+	return itemSet(line[:half]).Intersection(itemSet(line[half:]))
+}
+
+// groupLabel is the priority of the item all of the group carries
+func groupLabel(group []string) (int, error) {
+	common := itemSet(group[0])
+	for _, elf := range group[1:] {
+		common = common.Intersection(itemSet(elf))
+	}
+	return common.PopAny()
+}
+
+// invalidItem returns the index of the first item which isn't a letter, or -1
+func invalidItem(line string) int {
+	for i, item := range []byte(line) {
+		if !(item >= 'a' && item <= 'z' || item >= 'A' && item <= 'Z') {
+			return i
+		}
+	}
+	return -1
+}
+
+// Solver totals priorities as rucksacks are read, only holding on to the
+// current group of three
+type Solver struct {
+	total  int
+	labels int
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	group := make([]string, 0, 3)
+	return util.Scan(r, func(lineNo int, line string) error {
+		if i := invalidItem(line); i >= 0 {
+			return &util.ParseError{Line: lineNo, Column: i + 1, Text: line, Err: fmt.Errorf("Unknown item %q", line[i])}
+		}
+		duplicatedItems(line).Each(func(priority int) {
+			s.total += priority
+		})
+
+		group = append(group, line)
+		if len(group) < 3 {
+			return nil
+		}
+		common, err := groupLabel(group)
+		if err != nil {
+			return &util.ParseError{Line: lineNo, Text: line, Err: errors.New("Group has no common item")}
+		}
+		s.labels += common
+		group = group[:0]
+		return nil
+	})
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	return util.Int(s.total), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	return util.Int(s.labels), nil
+}
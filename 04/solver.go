@@ -0,0 +1,62 @@
+package day04
+
+import (
+	"io"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/parse"
+	"github.com/martin-nyaga/aoc-2022/util/rng"
+)
+
+func init() {
+	util.Register(4, "", func() util.Solver { return &Solver{} })
+}
+
+type RangePair [2]rng.Range
+
+func (r RangePair) HasFullContainment() bool {
+	return r[0].Covers(r[1]) || r[1].Covers(r[0])
+}
+
+func (r RangePair) HasIntersection() bool {
+	return r[0].Intersects(r[1])
+}
+
+func parsePair(lineNo int, line string) (RangePair, error) {
+	var pair RangePair
+	err := parse.Match("{int}-{int},{int}-{int}", line, &pair[0][0], &pair[0][1], &pair[1][0], &pair[1][1])
+	if err != nil {
+		return RangePair{}, parse.LineError(lineNo, line, err)
+	}
+	return pair, nil
+}
+
+type Solver struct {
+	containedPairs   int
+	overlappingPairs int
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	return util.Scan(r, func(lineNo int, line string) error {
+		pair, err := parsePair(lineNo, line)
+		if err != nil {
+			return err
+		}
+
+		if pair.HasFullContainment() {
+			s.containedPairs += 1
+			s.overlappingPairs += 1
+		} else if pair.HasIntersection() {
+			s.overlappingPairs += 1
+		}
+		return nil
+	})
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	return util.Int(s.containedPairs), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	return util.Int(s.overlappingPairs), nil
+}
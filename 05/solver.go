@@ -0,0 +1,131 @@
+package day05
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/parse"
+	"github.com/martin-nyaga/aoc-2022/util/slices"
+)
+
+func init() {
+	util.Register(5, "", func() util.Solver { return &Solver{} })
+}
+
+type Move [3]int
+
+func (m Move) Execute(stacks Stacks) {
+	count := m[0]
+	source := m[1]
+	target := m[2]
+
+	for i := 0; i < count; i++ {
+		popped, err := slices.Pop(&stacks[source])
+		util.HandleError(err)
+		stacks[target] = append(stacks[target], popped)
+	}
+}
+
+func (m Move) ExecuteInOrder(stacks Stacks) {
+	count := m[0]
+	source := m[1]
+	target := m[2]
+	popped, err := slices.PopN(&stacks[source], count)
+	util.HandleError(err)
+	stacks[target] = append(stacks[target], popped...)
+}
+
+type Stacks [][]byte
+
+func (s Stacks) Tops() []byte {
+	result := make([]byte, 0)
+	for _, stack := range s {
+		if len(stack) > 0 {
+			result = append(result, stack[len(stack)-1])
+		}
+	}
+	return result
+}
+
+func (s Stacks) Clone() Stacks {
+	result := make(Stacks, len(s))
+	for i, stack := range s {
+		result[i] = append([]byte{}, stack...)
+	}
+	return result
+}
+
+func (s Stacks) Print() {
+	for _, stack := range s {
+		fmt.Println(string(stack))
+	}
+}
+
+func parseInput(lines []string) (Stacks, []Move, error) {
+	blocks := parse.Blocks(lines)
+	if len(blocks) < 2 {
+		return nil, nil, &util.ParseError{Line: 1, Err: errors.New("Expected stacks and moves separated by a blank line")}
+	}
+	rawStacks := blocks[0]
+	rawMoves := blocks[1]
+
+	stacksCount := len(parse.Ints(rawStacks[len(rawStacks)-1]))
+	stacks := make([][]byte, stacksCount, stacksCount)
+	for i := len(rawStacks) - 2; i >= 0; i-- {
+		slice := rawStacks[i]
+		for j, ch := range []byte(slice) {
+			if j%4 == 1 && ch != ' ' {
+				stacks[j/4] = append(stacks[j/4], ch)
+			}
+		}
+	}
+
+	movesCount := len(rawMoves)
+	moves := make([]Move, movesCount, movesCount)
+	for i, line := range rawMoves {
+		var count, source, target int
+		err := parse.Match("move {int} from {int} to {int}", line, &count, &source, &target)
+		if err == nil && (source < 1 || source > stacksCount || target < 1 || target > stacksCount) {
+			err = fmt.Errorf("Stacks are numbered 1 to %d", stacksCount)
+		}
+		if err != nil {
+			lineNo := len(rawStacks) + 2 + i
+			return nil, nil, parse.LineError(lineNo, line, err)
+		}
+		moves[i] = Move{count, source - 1, target - 1}
+	}
+
+	return stacks, moves, nil
+}
+
+type Solver struct {
+	stacks Stacks
+	moves  []Move
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	lines, err := util.ReadLines(r)
+	if err != nil {
+		return err
+	}
+	s.stacks, s.moves, err = parseInput(lines)
+	return err
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	stacks := s.stacks.Clone()
+	for _, move := range s.moves {
+		move.Execute(stacks)
+	}
+	return util.Str(string(stacks.Tops())), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	stacks := s.stacks.Clone()
+	for _, move := range s.moves {
+		move.ExecuteInOrder(stacks)
+	}
+	return util.Str(string(stacks.Tops())), nil
+}
@@ -0,0 +1,81 @@
+package day06
+
+import (
+	"io"
+	"strings"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/counter"
+	"github.com/martin-nyaga/aoc-2022/util/slices"
+)
+
+func init() {
+	util.Register(6, "", func() util.Solver { return &Solver{} })
+}
+
+type Window struct {
+	size   int
+	counts counter.Counter[byte]
+	slice  []byte
+}
+
+func newWindow(bytes []byte) Window {
+	newBytes := make([]byte, len(bytes))
+	copy(newBytes, bytes)
+	return Window{size: len(bytes), counts: counter.New(bytes...), slice: newBytes}
+}
+
+func (w *Window) IsUnique() bool {
+	return w.counts.Distinct() == w.size
+}
+
+func (w *Window) Add(ch byte) {
+	w.ShiftChar()
+	w.counts.Inc(ch)
+	w.slice = append(w.slice, ch)
+}
+
+func (w *Window) ShiftChar() {
+	ch, err := slices.Shift(&w.slice)
+	util.HandleError(err)
+	util.HandleError(w.counts.Dec(ch))
+}
+
+type Solver struct {
+	bytes []byte
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	input, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	s.bytes = []byte(strings.TrimSpace(string(input)))
+	return nil
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	window := newWindow(s.bytes[0:4])
+	var packetStartIndex int
+	for i := 4; i < len(s.bytes); i++ {
+		packetStartIndex = i
+		window.Add(s.bytes[i])
+		if window.IsUnique() {
+			break
+		}
+	}
+	return util.Int(packetStartIndex + 1), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	window := newWindow(s.bytes[0:14])
+	var messageStartIndex int
+	for i := 4; i < len(s.bytes); i++ {
+		messageStartIndex = i
+		window.Add(s.bytes[i])
+		if window.IsUnique() {
+			break
+		}
+	}
+	return util.Int(messageStartIndex + 1), nil
+}
@@ -0,0 +1,181 @@
+package day07
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/parse"
+	"github.com/martin-nyaga/aoc-2022/util/slices"
+)
+
+func init() {
+	util.Register(7, "", func() util.Solver { return &Solver{} })
+}
+
+type Sizer interface {
+	Size() int
+}
+
+type File struct {
+	name string
+	size int
+}
+
+func (f *File) Size() int {
+	return f.size
+}
+
+type Dir struct {
+	name  string
+	files []*File
+	dirs  []*Dir
+}
+
+func (d *Dir) AddFile(f *File) {
+	d.files = append(d.files, f)
+}
+
+func (d *Dir) AddDir(dir *Dir) {
+	d.dirs = append(d.dirs, dir)
+}
+
+func (d *Dir) Size() int {
+	size := 0
+	for _, f := range d.files {
+		size += f.Size()
+	}
+	for _, dir := range d.dirs {
+		size += dir.Size()
+	}
+	return size
+}
+
+func newDir(name string) Dir {
+	return Dir{name, make([]*File, 0), make([]*Dir, 0)}
+}
+
+type FS struct {
+	files []*File
+	dirs  []*Dir
+}
+
+func parseInput(lines []string) (FS, error) {
+	fs := FS{make([]*File, 0), make([]*Dir, 0)}
+	stack := make([]*Dir, 0)
+
+	i := 0
+	for i < len(lines) {
+		line := lines[i]
+		if !strings.HasPrefix(line, "$ ") || len(line) < 4 {
+			return fs, &util.ParseError{Line: i + 1, Column: 1, Text: line, Err: errors.New("Expected a command")}
+		}
+		command := line[2:]
+		prefix := command[0:2]
+		switch prefix {
+		case "cd":
+			if len(command) < 4 {
+				return fs, &util.ParseError{Line: i + 1, Column: 5, Text: line, Err: errors.New("Expected a directory")}
+			}
+			dirName := command[3:]
+			if dirName == ".." {
+				_, err := slices.Pop(&stack)
+				if err != nil {
+					return fs, &util.ParseError{Line: i + 1, Column: 6, Text: line, Err: err}
+				}
+			} else {
+				if dirName == "/" {
+					dir := newDir(dirName)
+					fs.dirs = append(fs.dirs, &dir)
+					stack = append(stack, &dir)
+				} else {
+					if len(stack) == 0 {
+						return fs, &util.ParseError{Line: i + 1, Column: 6, Text: line, Err: errors.New("No current directory")}
+					}
+					var nextDir *Dir
+					curDir := stack[len(stack)-1]
+					for _, d := range curDir.dirs {
+						if d.name == dirName {
+							nextDir = d
+							break
+						}
+					}
+					if nextDir == nil {
+						return fs, &util.ParseError{Line: i + 1, Column: 6, Text: line, Err: fmt.Errorf("Unknown directory %q", dirName)}
+					}
+					stack = append(stack, nextDir)
+				}
+			}
+			i += 1
+		case "ls":
+			if len(stack) == 0 {
+				return fs, &util.ParseError{Line: i + 1, Column: 3, Text: line, Err: errors.New("No current directory")}
+			}
+			i += 1
+			curDir := stack[len(stack)-1]
+			for i < len(lines) && !strings.HasPrefix(lines[i], "$") {
+				line := lines[i]
+				if strings.HasPrefix(line, "dir ") {
+					name := line[4:]
+					dir := newDir(name)
+					fs.dirs = append(fs.dirs, &dir)
+					curDir.AddDir(&dir)
+				} else {
+					var file File
+					if err := parse.Match("{int} {any}", line, &file.size, &file.name); err != nil {
+						return fs, parse.LineError(i+1, line, err)
+					}
+					fs.files = append(fs.files, &file)
+					curDir.AddFile(&file)
+				}
+				i += 1
+			}
+		default:
+			return fs, &util.ParseError{Line: i + 1, Column: 3, Text: line, Err: fmt.Errorf("Unknown command %q", prefix)}
+		}
+	}
+
+	if len(fs.dirs) == 0 {
+		return fs, &util.ParseError{Line: 1, Err: errors.New("No directories found")}
+	}
+	return fs, nil
+}
+
+type Solver struct {
+	fs FS
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	lines, err := util.ReadLines(r)
+	if err != nil {
+		return err
+	}
+	s.fs, err = parseInput(lines)
+	return err
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	totalSizeOfSmallDirs := 0
+	for _, dir := range s.fs.dirs {
+		size := dir.Size()
+		if size < 100000 {
+			totalSizeOfSmallDirs += size
+		}
+	}
+	return util.Int(totalSizeOfSmallDirs), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	freeSpace := 70000000 - s.fs.dirs[0].Size()
+	targetFreeSpace := 30000000
+	delta := targetFreeSpace - freeSpace
+	currentTarget := s.fs.dirs[0]
+	for _, dir := range s.fs.dirs {
+		if dir.Size() > delta && dir.Size() < currentTarget.Size() {
+			currentTarget = dir
+		}
+	}
+	return util.Int(currentTarget.Size()), nil
+}
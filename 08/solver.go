@@ -0,0 +1,106 @@
+package day08
+
+import (
+	"io"
+	"strings"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/geom"
+	"github.com/martin-nyaga/aoc-2022/util/grid"
+)
+
+func init() {
+	util.Register(8, "", func() util.Solver { return &Solver{} })
+}
+
+type Grid struct {
+	grid.Grid[byte]
+}
+
+// scan looks from the tree at p towards the edge in direction d, invoking the
+// callback for each encountered tree. If the callback returns true, the scan
+// is continued, otherwise, it stops
+func (g *Grid) scan(p geom.Point, d geom.Direction, callback func(tree, next byte) bool) {
+	tree := g.At(p)
+	g.Ray(p, d.Vector(), func(_ geom.Point, next byte) bool {
+		return callback(tree, next)
+	})
+}
+
+func (g *Grid) IsVisible(p geom.Point) bool {
+	for _, d := range geom.Directions {
+		if g.visibleFromDirection(p, d) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Grid) visibleFromDirection(p geom.Point, d geom.Direction) bool {
+	canBeSeen := true
+	g.scan(p, d, func(tree, next byte) bool {
+		if tree <= next {
+			canBeSeen = false
+			return false
+		}
+		return true
+	})
+	return canBeSeen
+}
+
+func (g *Grid) ScenicScore(p geom.Point) int {
+	score := 1
+	for _, d := range geom.Directions {
+		score *= g.countTreesSeenInDirection(p, d)
+	}
+	return score
+}
+
+func (g *Grid) countTreesSeenInDirection(p geom.Point, d geom.Direction) int {
+	seen := 0
+	g.scan(p, d, func(tree, next byte) bool {
+		seen += 1
+		if next >= tree {
+			return false
+		}
+		return true
+	})
+	return seen
+}
+
+type Solver struct {
+	grid Grid
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	lines, err := util.ReadLines(r)
+	if err != nil {
+		return err
+	}
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	s.grid.Grid, err = grid.ParseBytes(lines)
+	return err
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	visibleCount := 0
+	s.grid.Each(func(p geom.Point, _ byte) {
+		if s.grid.IsVisible(p) {
+			visibleCount += 1
+		}
+	})
+	return util.Int(visibleCount), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	highestScore := 0
+	s.grid.Each(func(p geom.Point, _ byte) {
+		score := s.grid.ScenicScore(p)
+		if score > highestScore {
+			highestScore = score
+		}
+	})
+	return util.Int(highestScore), nil
+}
@@ -0,0 +1,124 @@
+package day09
+
+import (
+	"io"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/geom"
+	"github.com/martin-nyaga/aoc-2022/util/grid"
+	"github.com/martin-nyaga/aoc-2022/util/parse"
+)
+
+func init() {
+	util.Register(9, "", func() util.Solver { return &Solver{} })
+}
+
+type Move struct {
+	direction geom.Direction
+	steps     int
+}
+
+type TrackedPoint struct {
+	point   geom.Point
+	tracker *grid.SparseGrid[bool]
+	next    *TrackedPoint
+}
+
+func newTrackedPoint(point geom.Point) TrackedPoint {
+	var t TrackedPoint
+	t.point = point
+	t.tracker = grid.NewSparse(false)
+	t.tracker.Set(point, true)
+	t.next = nil
+	return t
+}
+
+func (t *TrackedPoint) MoveAndPropagate(m *Move) {
+	step := m.direction.Vector()
+	for i := 0; i < m.steps; i++ {
+		t.point = t.point.Add(step)
+		t.Propagate()
+	}
+
+	t.tracker.Set(t.point, true)
+}
+
+func (t *TrackedPoint) Propagate() {
+	if t.next != nil {
+		t.next.FollowAndPropagate(&t.point)
+	}
+}
+
+func (t *TrackedPoint) FollowAndPropagate(prev *geom.Point) {
+	d := prev.Sub(t.point)
+	if d.Chebyshev() <= 1 {
+		// Touching, nothing to do
+		return
+	}
+
+	// One step straight towards it if in line, otherwise diagonally
+	t.point = t.point.Add(d.Sign())
+
+	t.tracker.Set(t.point, true)
+	t.Propagate()
+}
+
+func parseMove(lineNo int, line string) (Move, error) {
+	var direction string
+	var steps int
+	err := parse.Match("{word} {int}", line, &direction, &steps)
+	if err != nil {
+		return Move{}, parse.LineError(lineNo, line, err)
+	}
+	d, err := geom.ParseDirection(direction)
+	if err != nil {
+		return Move{}, &util.ParseError{Line: lineNo, Column: 1, Text: line, Err: err}
+	}
+	return Move{d, steps}, nil
+}
+
+// newRope links up the given number of knots, returning the head and tail
+func newRope(knots int) (*TrackedPoint, *TrackedPoint) {
+	head := newTrackedPoint(geom.Point{0, 0})
+	curr := &head
+	for i := 1; i < knots; i++ {
+		next := newTrackedPoint(geom.Point{0, 0})
+		curr.next = &next
+		curr = &next
+	}
+	return &head, curr
+}
+
+type Solver struct {
+	moves []Move
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	s.moves = make([]Move, 0)
+	return util.Scan(r, func(lineNo int, line string) error {
+		move, err := parseMove(lineNo, line)
+		if err != nil {
+			return err
+		}
+		s.moves = append(s.moves, move)
+		return nil
+	})
+}
+
+// tailPositions moves a rope with the given number of knots, returning how
+// many positions its tail visited
+func (s *Solver) tailPositions(knots int) int {
+	head, tail := newRope(knots)
+	for i := range s.moves {
+		head.MoveAndPropagate(&s.moves[i])
+	}
+	return tail.tracker.Len()
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	return util.Int(s.tailPositions(2)), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	return util.Int(s.tailPositions(10)), nil
+}
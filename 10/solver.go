@@ -0,0 +1,138 @@
+package day10
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/geom"
+	"github.com/martin-nyaga/aoc-2022/util/grid"
+	"github.com/martin-nyaga/aoc-2022/util/parse"
+	"github.com/martin-nyaga/aoc-2022/util/slices"
+)
+
+func init() {
+	util.Register(10, "", func() util.Solver { return &Solver{} })
+}
+
+const (
+	Addx = "addx"
+	Noop = "noop"
+)
+
+type Insn struct {
+	kind string
+	arg  int
+}
+
+type Cpu struct {
+	x       int
+	cycles  int
+	samples []int
+	crt     Crt
+}
+
+func newCpu() Cpu {
+	return Cpu{
+		x:       1,
+		cycles:  0,
+		samples: make([]int, 0),
+		crt:     Crt{screen: grid.New[byte](40, 6)},
+	}
+}
+
+func (c *Cpu) Process(insn *Insn) {
+	switch insn.kind {
+	case Addx:
+		c.nextCycle()
+		c.nextCycle()
+		c.x += insn.arg
+	case Noop:
+		c.nextCycle()
+	}
+}
+
+func (c *Cpu) nextCycle() {
+	c.cycles += 1
+	if c.cycles == 20 || c.cycles <= 220 && ((c.cycles-20)%40 == 0) {
+		c.samples = append(c.samples, c.cycles*c.x)
+	}
+	c.crt.Draw(c.x)
+}
+
+type Crt struct {
+	currentPixel int
+	screen       grid.Grid[byte]
+}
+
+func (c *Crt) Draw(x int) {
+	width := c.screen.Width()
+	pixel := geom.Point{c.currentPixel % width, c.currentPixel / width}
+	if !c.screen.InBounds(pixel) {
+		// Programs longer than a frame leave the last frame on screen
+		return
+	}
+	if geom.Abs(x-pixel.X()) <= 1 {
+		c.screen.Set(pixel, '#')
+	} else {
+		c.screen.Set(pixel, '.')
+	}
+	c.currentPixel += 1
+}
+
+func (c *Crt) String() string {
+	return c.screen.String()
+}
+
+func parseInsn(lineNo int, line string) (Insn, error) {
+	var insn Insn
+	insn.kind, _, _ = strings.Cut(line, " ")
+	var err error
+	switch insn.kind {
+	case Addx:
+		err = parse.Match(Addx+" {int}", line, &insn.arg)
+	case Noop:
+		err = parse.Match(Noop, line)
+	default:
+		return insn, &util.ParseError{Line: lineNo, Column: 1, Text: line, Err: fmt.Errorf("Unknown instruction %q", insn.kind)}
+	}
+	if err != nil {
+		return insn, parse.LineError(lineNo, line, err)
+	}
+	return insn, nil
+}
+
+type Solver struct {
+	program []Insn
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	s.program = make([]Insn, 0)
+	return util.Scan(r, func(lineNo int, line string) error {
+		insn, err := parseInsn(lineNo, line)
+		if err != nil {
+			return err
+		}
+		s.program = append(s.program, insn)
+		return nil
+	})
+}
+
+func (s *Solver) run() Cpu {
+	cpu := newCpu()
+	for i := range s.program {
+		cpu.Process(&s.program[i])
+	}
+	return cpu
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	cpu := s.run()
+	return util.Int(slices.Sum(cpu.samples)), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	cpu := s.run()
+	return util.Str(cpu.crt.String()), nil
+}
@@ -0,0 +1,14 @@
+// Package day11 has what the two parts of day 11 share
+package day11
+
+import "github.com/martin-nyaga/aoc-2022/util/counter"
+
+// MonkeyBusiness multiplies the inspections of the two busiest monkeys, or is
+// zero if fewer than two monkeys inspected anything
+func MonkeyBusiness(inspections *counter.Counter[int]) int {
+	busiest := inspections.MostCommon(2)
+	if len(busiest) < 2 {
+		return 0
+	}
+	return busiest[0].Count * busiest[1].Count
+}
@@ -0,0 +1,152 @@
+package part1
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	day11 "github.com/martin-nyaga/aoc-2022/11"
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/counter"
+	"github.com/martin-nyaga/aoc-2022/util/parse"
+)
+
+func init() {
+	util.Register(11, "part1", func() util.Solver { return &Solver{} })
+}
+
+type Monkey struct {
+	items       []int
+	operation   func(int) int
+	divisor     int
+	trueTarget  int
+	falseTarget int
+}
+
+// PlayTurn throws all the monkey's items, returning how many it inspected
+func (m *Monkey) PlayTurn(troop []*Monkey) int {
+	itemCount := len(m.items)
+	for i := 0; i < itemCount; i++ {
+		item := m.items[0]
+		m.items = m.items[1:]
+		item = m.Inspect(item)
+		item = CalmDown(item)
+		var target *Monkey
+		if item%m.divisor == 0 {
+			target = troop[m.trueTarget]
+		} else {
+			target = troop[m.falseTarget]
+		}
+		target.items = append(target.items, item)
+	}
+	return itemCount
+}
+
+func (m *Monkey) Inspect(item int) int {
+	return m.operation(item)
+}
+
+func CalmDown(item int) int {
+	return item / 3
+}
+
+func parseOperation(line string) (func(int) int, error) {
+	var operator, operand string
+	err := parse.Match("Operation: new = old {any} {word}", line, &operator, &operand)
+	if err != nil {
+		return nil, err
+	}
+
+	if operand == "old" {
+		if operator == "*" {
+			return func(old int) int { return old * old }, nil
+		}
+		return func(old int) int { return old + old }, nil
+	}
+
+	n, err := strconv.Atoi(operand)
+	if err != nil {
+		return nil, err
+	}
+	if operator == "*" {
+		return func(old int) int { return old * n }, nil
+	}
+	return func(old int) int { return old + n }, nil
+}
+
+func parseMonkey(lines []string) (*Monkey, error) {
+	var monkey Monkey
+	var err error
+
+	var rawItems string
+	if err = parse.Match("Starting items: {any}", lines[1], &rawItems); err != nil {
+		return nil, err
+	}
+	monkey.items = parse.Ints(rawItems)
+
+	monkey.operation, err = parseOperation(lines[2])
+	if err != nil {
+		return nil, err
+	}
+	if err = parse.Match("Test: divisible by {int}", lines[3], &monkey.divisor); err != nil {
+		return nil, err
+	}
+	if err = parse.Match("If true: throw to monkey {int}", lines[4], &monkey.trueTarget); err != nil {
+		return nil, err
+	}
+	if err = parse.Match("If false: throw to monkey {int}", lines[5], &monkey.falseTarget); err != nil {
+		return nil, err
+	}
+	return &monkey, nil
+}
+
+func parseInput(lines []string) ([]*Monkey, error) {
+	troop := make([]*Monkey, 0)
+	for _, block := range parse.Blocks(lines) {
+		if len(block) < 6 {
+			continue
+		}
+		monkeyLines := make([]string, len(block))
+		for j, line := range block {
+			monkeyLines[j] = strings.TrimSpace(line)
+		}
+		monkey, err := parseMonkey(monkeyLines)
+		if err != nil {
+			return nil, err
+		}
+		troop = append(troop, monkey)
+	}
+	return troop, nil
+}
+
+type Solver struct {
+	lines []string
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	lines, err := util.ReadLines(r)
+	s.lines = lines
+	return err
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	troop, err := parseInput(s.lines)
+	if err != nil {
+		return util.Answer{}, err
+	}
+
+	inspections := counter.New[int]()
+	round := 0
+	for round < 20 {
+		for i, monkey := range troop {
+			inspections.Add(i, monkey.PlayTurn(troop))
+		}
+		round += 1
+	}
+
+	return util.Int(day11.MonkeyBusiness(&inspections)), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	return util.Answer{}, util.ErrUnsolved
+}
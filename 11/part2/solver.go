@@ -0,0 +1,202 @@
+package part2
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	day11 "github.com/martin-nyaga/aoc-2022/11"
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/counter"
+	"github.com/martin-nyaga/aoc-2022/util/parse"
+)
+
+func init() {
+	util.Register(11, "part2", func() util.Solver { return &Solver{} })
+}
+
+type Item map[int]int
+
+func makeItems(rawItems []int, divisors []int) []*Item {
+	items := make([]*Item, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		item := make(Item)
+		for _, divisor := range divisors {
+			item[divisor] = rawItem
+		}
+		items = append(items, &item)
+	}
+	return items
+}
+
+func (i *Item) Add(x int) {
+	for base, item := range *i {
+		(*i)[base] = (item % base) + (x % base)
+	}
+}
+
+func (i *Item) Mul(x int) {
+	for base, item := range *i {
+		(*i)[base] = ((item % base) * (x % base)) % base
+	}
+}
+
+func (i *Item) Square() {
+	for base, item := range *i {
+		(*i)[base] = ((item % base) * (item % base)) % base
+	}
+}
+
+func (i *Item) DivisibleBy(x int) bool {
+	return (*i)[x]%x == 0
+}
+
+type Monkey struct {
+	items       []*Item
+	operation   func(*Item)
+	divisor     int
+	trueTarget  int
+	falseTarget int
+}
+
+// PlayTurn throws all the monkey's items, returning how many it inspected
+func (m *Monkey) PlayTurn(troop []*Monkey) int {
+	itemCount := len(m.items)
+	for i := 0; i < itemCount; i++ {
+		item := m.items[0]
+		m.items = m.items[1:]
+		m.Inspect(item)
+		var target *Monkey
+		if item.DivisibleBy(m.divisor) {
+			target = troop[m.trueTarget]
+		} else {
+			target = troop[m.falseTarget]
+		}
+		target.items = append(target.items, item)
+	}
+	return itemCount
+}
+
+func (m *Monkey) Inspect(item *Item) {
+	m.operation(item)
+}
+
+type rawMonkey struct {
+	items       []int
+	operator    string
+	operand     string
+	divisor     int
+	trueTarget  int
+	falseTarget int
+}
+
+func parseMonkey(lines []string) (rawMonkey, error) {
+	var monkey rawMonkey
+	var err error
+
+	var rawItems string
+	if err = parse.Match("Starting items: {any}", lines[1], &rawItems); err != nil {
+		return monkey, err
+	}
+	monkey.items = parse.Ints(rawItems)
+
+	if err = parse.Match("Operation: new = old {any} {word}", lines[2], &monkey.operator, &monkey.operand); err != nil {
+		return monkey, err
+	}
+	if err = parse.Match("Test: divisible by {int}", lines[3], &monkey.divisor); err != nil {
+		return monkey, err
+	}
+	if err = parse.Match("If true: throw to monkey {int}", lines[4], &monkey.trueTarget); err != nil {
+		return monkey, err
+	}
+	if err = parse.Match("If false: throw to monkey {int}", lines[5], &monkey.falseTarget); err != nil {
+		return monkey, err
+	}
+	return monkey, nil
+}
+
+func (m rawMonkey) operation() (func(*Item), error) {
+	if m.operand == "old" {
+		if m.operator == "*" {
+			return func(old *Item) { old.Square() }, nil
+		}
+		return func(old *Item) { old.Mul(2) }, nil
+	}
+
+	n, err := strconv.Atoi(m.operand)
+	if err != nil {
+		return nil, err
+	}
+	if m.operator == "*" {
+		return func(old *Item) { old.Mul(n) }, nil
+	}
+	return func(old *Item) { old.Add(n) }, nil
+}
+
+func parseInput(lines []string) ([]*Monkey, error) {
+	rawMonkeys := make([]rawMonkey, 0)
+	divisors := make([]int, 0)
+	for _, block := range parse.Blocks(lines) {
+		if len(block) < 6 {
+			continue
+		}
+		monkeyLines := make([]string, len(block))
+		for j, line := range block {
+			monkeyLines[j] = strings.TrimSpace(line)
+		}
+		raw, err := parseMonkey(monkeyLines)
+		if err != nil {
+			return nil, err
+		}
+		rawMonkeys = append(rawMonkeys, raw)
+		divisors = append(divisors, raw.divisor)
+	}
+
+	troop := make([]*Monkey, 0, len(rawMonkeys))
+	for _, raw := range rawMonkeys {
+		operation, err := raw.operation()
+		if err != nil {
+			return nil, err
+		}
+		troop = append(troop, &Monkey{
+			items:       makeItems(raw.items, divisors),
+			operation:   operation,
+			divisor:     raw.divisor,
+			trueTarget:  raw.trueTarget,
+			falseTarget: raw.falseTarget,
+		})
+	}
+	return troop, nil
+}
+
+type Solver struct {
+	lines []string
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	lines, err := util.ReadLines(r)
+	s.lines = lines
+	return err
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	return util.Answer{}, util.ErrUnsolved
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	troop, err := parseInput(s.lines)
+	if err != nil {
+		return util.Answer{}, err
+	}
+
+	inspections := counter.New[int]()
+	round := 0
+	for round < 10000 {
+		for i, monkey := range troop {
+			inspections.Add(i, monkey.PlayTurn(troop))
+		}
+		round += 1
+	}
+
+	return util.Int(day11.MonkeyBusiness(&inspections)), nil
+}
@@ -0,0 +1,109 @@
+package part1
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/geom"
+	"github.com/martin-nyaga/aoc-2022/util/grid"
+	"github.com/martin-nyaga/aoc-2022/util/search"
+)
+
+func init() {
+	util.Register(12, "part1", func() util.Solver { return &Solver{} })
+}
+
+// HeightMap is a search.Graph of the points, with an edge to each neighbour
+// which is at most one higher
+type HeightMap struct {
+	start geom.Point
+	grid  grid.Grid[byte]
+	goal  geom.Point
+}
+
+func (h *HeightMap) Neighbours(point geom.Point) []geom.Point {
+	result := make([]geom.Point, 0, 4)
+	for _, nextPoint := range h.grid.Neighbours4(point) {
+		if h.canReach(point, nextPoint) {
+			result = append(result, nextPoint)
+		}
+	}
+	return result
+}
+
+func (h *HeightMap) At(p geom.Point) byte {
+	return h.grid.At(p)
+}
+
+func (h *HeightMap) canReach(source, dest geom.Point) bool {
+	return (int(h.At(dest)) - int(h.At(source))) <= 1
+}
+
+func (h *HeightMap) Cost(from, to geom.Point) int {
+	return 1
+}
+
+func (h *HeightMap) Heuristic(point geom.Point) int {
+	return geom.Manhattan(point, h.goal)
+}
+
+func (h *HeightMap) isGoal(point geom.Point) bool {
+	return point == h.goal
+}
+
+func (h *HeightMap) Print() {
+	fmt.Print(h.grid.String())
+}
+
+func parseInput(lines []string) (HeightMap, error) {
+	var start, goal geom.Point
+	heights, err := grid.Parse(lines, func(p geom.Point, char byte) (byte, error) {
+		if char == 'E' {
+			goal = p
+			char = 'z'
+		}
+		if char == 'S' {
+			start = p
+			char = 'a'
+		}
+		return char, nil
+	})
+
+	return HeightMap{
+		start: start,
+		grid:  heights,
+		goal:  goal,
+	}, err
+}
+
+type Solver struct {
+	heightMap HeightMap
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	lines, err := util.ReadLines(r)
+	if err != nil {
+		return err
+	}
+	s.heightMap, err = parseInput(lines)
+	return err
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	heightMap := s.heightMap
+	if *util.Debug {
+		heightMap.Print()
+	}
+	result, err := search.AStar[geom.Point](&heightMap, []geom.Point{heightMap.start}, heightMap.isGoal)
+	if err != nil {
+		return util.Answer{}, err
+	}
+	util.Debugln("Found path!", result.Path)
+	util.Debugln(fmt.Sprintf("%+v", result.Stats))
+	return util.Int(result.Distance), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	return util.Answer{}, util.ErrUnsolved
+}
@@ -1,14 +1,18 @@
-package main
+package day13
 
 import (
 	"encoding/json"
-	"flag"
 	"fmt"
+	"io"
 	"sort"
 
 	"github.com/martin-nyaga/aoc-2022/util"
 )
 
+func init() {
+	util.Register(13, "", func() util.Solver { return &Solver{} })
+}
+
 type Ordering int
 
 const (
@@ -85,8 +89,7 @@ func checkOrder(left, right interface{}) Ordering {
 	panic("Match failed!")
 }
 
-func parseInput() [][2][]interface{} {
-	lines := util.NewInputFile("13").ReadLines()
+func parseInput(lines []string) [][2][]interface{} {
 	packetPairs := make([][2][]interface{}, 0)
 	var packetPair [2][]interface{}
 	i := 0
@@ -114,18 +117,32 @@ func (a ByOrder) Len() int           { return len(a) }
 func (a ByOrder) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a ByOrder) Less(i, j int) bool { return checkOrder(a[i], a[j]) == Correct }
 
-func main() {
-	flag.Parse()
-	packetPairs := parseInput()
+type Solver struct {
+	packetPairs [][2][]interface{}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	lines, err := util.ReadLines(r)
+	if err != nil {
+		return err
+	}
+	s.packetPairs = parseInput(lines)
+	return nil
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
 	result := 0
-	for i, pair := range packetPairs {
+	for i, pair := range s.packetPairs {
 		if checkOrder(interface{}(pair[0]), interface{}(pair[1])) == Correct {
 			result += i + 1
 		}
 	}
-	fmt.Println("Part 1:", result)
+	return util.Int(result), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
 	packets := make([][]interface{}, 0)
-	for _, pair := range packetPairs {
+	for _, pair := range s.packetPairs {
 		packets = append(packets, pair[0], pair[1])
 	}
 
@@ -137,7 +154,9 @@ func main() {
 	var i, j int
 	for index, packet := range packets {
 		marshalled, err := json.Marshal(packet)
-		util.HandleError(err)
+		if err != nil {
+			return util.Answer{}, err
+		}
 		if string(marshalled) == "[[2]]" {
 			i = index + 1
 		}
@@ -145,6 +164,6 @@ func main() {
 			j = index + 1
 		}
 	}
-	fmt.Println(i, j)
-	fmt.Println("Part 2:", i*j)
+	util.Debugln(i, j)
+	return util.Int(i * j), nil
 }
@@ -0,0 +1,195 @@
+package part2
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	tm "github.com/buger/goterm"
+	"github.com/eiannone/keyboard"
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/geom"
+	"github.com/martin-nyaga/aoc-2022/util/grid"
+	"github.com/martin-nyaga/aoc-2022/util/parse"
+)
+
+func init() {
+	util.Register(14, "part2", func() util.Solver { return &Solver{} })
+}
+
+var logBox = tm.NewBox(30|tm.PCT, 20, 5)
+var caveBox = tm.NewBox(50|tm.PCT, 50, 0)
+
+func log(str string) {
+	fmt.Fprint(logBox, str+"\n")
+}
+
+const (
+	Rock = '#'
+	Sand = 'o'
+)
+
+type Cave struct {
+	cells *grid.SparseGrid[byte]
+	// floor is two below the lowest rock, and goes on forever
+	floor  int
+	grains int
+}
+
+func (c *Cave) nextGrain() geom.Point {
+	return geom.Point{500, 0}
+}
+
+func (c *Cave) canMoveTo(p geom.Point) bool {
+	if p[1] >= c.floor {
+		log("Blocked by floor!")
+		return false
+	}
+	switch c.cells.At(p) {
+	case Sand:
+		log("Blocked by grain!")
+		return false
+	case Rock:
+		log("Blocked by rock!")
+		return false
+	}
+	return true
+}
+
+func pause() {
+	if *util.Step {
+		// Progress by pressing any key
+		_, key, err := keyboard.GetSingleKey()
+		util.HandleError(err)
+		if key == keyboard.KeyEsc {
+			panic("Escape pressed!")
+		}
+	}
+}
+
+func (c *Cave) addSandUntilDone() {
+	for {
+		g := c.nextGrain()
+
+		settledAtRoot := false
+		settled := false
+
+		for {
+			// Pause and draw the current state for debuging
+			if *util.Draw {
+				pause()
+				c.cells.Set(g, Sand)
+				c.Draw()
+				c.cells.Delete(g)
+			}
+
+			if settled {
+				log(fmt.Sprintf("%#v settled", g))
+				if g == c.nextGrain() {
+					log(fmt.Sprintf("%#v blocked the entrance, the sand has stopped!", g))
+					settledAtRoot = true
+				}
+				break
+			}
+
+			// Try move down
+			down := g.Add(geom.Down.Vector())
+			if c.canMoveTo(down) {
+				log(fmt.Sprintf("%#v can move down", g))
+				g = down
+				continue
+			}
+			// Try move diagonally left
+			downLeft := down.Add(geom.Left.Vector())
+			if c.canMoveTo(downLeft) {
+				log(fmt.Sprintf("%#v can move down left", g))
+				g = downLeft
+				continue
+			}
+			// Try move diagonally right
+			downRight := down.Add(geom.Right.Vector())
+			if c.canMoveTo(downRight) {
+				log(fmt.Sprintf("%#v can move down right", g))
+				g = downRight
+				continue
+			}
+
+			settled = true
+		}
+
+		c.cells.Set(g, Sand)
+		c.grains += 1
+		if settledAtRoot {
+			log(fmt.Sprintf("%#v filled up the cave", g))
+			break
+		}
+	}
+}
+
+func (c *Cave) Draw() {
+	tm.Clear()
+
+	view, _ := c.cells.Bounds()
+	view = view.Extend(c.nextGrain())
+	view.Min[0] -= 20
+	view.Max[0] += 20
+	tm.MoveCursor(1, 1)
+	tm.Print(c.cells.Render(view, func(b byte) string { return string([]byte{b}) }))
+	if view.Max[1] < c.floor {
+		tm.Print(strings.Repeat("\n", c.floor-view.Max[1]-1))
+		tm.Print(strings.Repeat(string(Rock), view.Width()))
+	}
+
+	// print log box
+	tm.Print(tm.MoveTo(logBox.String(), 70|tm.PCT, 5|tm.PCT))
+	tm.Flush()
+
+	// Truncate logbox after a while
+	if logBox.Buf.Len() >= 400 {
+		logBox.Buf.Reset()
+	}
+	fmt.Println()
+}
+
+func parseInput(lines []string) (Cave, error) {
+	cells := grid.NewSparse[byte](' ')
+	for i, line := range lines {
+		points, err := parse.Points(line)
+		if err != nil {
+			return Cave{}, parse.LineError(i+1, line, err)
+		}
+		for j := 1; j < len(points); j++ {
+			segment := geom.Segment{A: geom.Point(points[j-1]), B: geom.Point(points[j])}
+			if !segment.Horizontal() && !segment.Vertical() {
+				return Cave{}, &util.ParseError{Line: i + 1, Text: line, Err: fmt.Errorf("Rock from %v to %v isn't straight", segment.A, segment.B)}
+			}
+			segment.Each(func(p geom.Point) {
+				cells.Set(p, Rock)
+			})
+		}
+	}
+	bounds, _ := cells.Bounds()
+	return Cave{cells: cells, floor: bounds.Max[1] + 2}, nil
+}
+
+type Solver struct {
+	cave Cave
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	lines, err := util.ReadLines(r)
+	if err != nil {
+		return err
+	}
+	s.cave, err = parseInput(lines)
+	return err
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	return util.Answer{}, util.ErrUnsolved
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	s.cave.addSandUntilDone()
+	return util.Int(s.cave.grains), nil
+}
@@ -0,0 +1,321 @@
+package day15
+
+import (
+	"errors"
+	"io"
+	"math"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/geom"
+	"github.com/martin-nyaga/aoc-2022/util/parse"
+	"github.com/martin-nyaga/aoc-2022/util/rng"
+)
+
+func init() {
+	util.Register(15, "", func() util.Solver { return &Solver{} })
+}
+
+type Area [4]geom.Point
+type Line [2]geom.Point
+
+type SensorBeaconPair struct {
+	sensor geom.Point
+	beacon geom.Point
+	area   *Area
+}
+
+func orientation(a, b, c geom.Point) int {
+	b0 := b.Sub(a)
+	c0 := c.Sub(a)
+	return int(math.Copysign(1, float64(b0[0]*c0[0]+b0[1]*c0[1])))
+}
+
+func (a Area) includes(p geom.Point) bool {
+	o1 := orientation(a[0], a[1], p)
+	o2 := orientation(a[1], a[2], p)
+	o3 := orientation(a[2], a[3], p)
+	o4 := orientation(a[3], a[0], p)
+
+	return o1 == o2 && o2 == o3 && o3 == o4
+}
+
+func (a Area) size() int {
+	xSpan := (a[1][0] - a[0][0]) + 1
+	ySpan := (a[2][1] - a[0][1]) + 1
+	return xSpan * ySpan
+}
+
+func (sb *SensorBeaconPair) Area() Area {
+	if sb.area != nil {
+		return *sb.area
+	}
+	r := sb.radius()
+	left := geom.Point{sb.sensor[0] - r, sb.sensor[1]}
+	right := geom.Point{sb.sensor[0] + r, sb.sensor[1]}
+	top := geom.Point{sb.sensor[0], sb.sensor[1] - r}
+	bottom := geom.Point{sb.sensor[0], sb.sensor[1] + r}
+
+	sb.area = &Area{top, right, bottom, left}
+
+	return *sb.area
+}
+
+func (sb *SensorBeaconPair) fringeAreas() []Area {
+	areas := make([]Area, 0)
+	xDiff := geom.Abs(sb.Area()[3][0] - sb.sensor[0])
+	yDiff := geom.Abs(sb.Area()[0][1] - sb.sensor[1])
+
+	topLeftX := sb.Area()[3][0]
+	topLeftY := sb.Area()[0][1]
+
+	for j := 0; j < 4; j++ {
+		for i := 0; i < 4; i++ {
+			if j > 0 && j < 3 && i > 0 && i < 3 {
+				continue
+			}
+			topLeft := geom.Point{topLeftX + i*xDiff, topLeftY + j*yDiff}
+			topRight := geom.Point{topLeft[0] + xDiff, topLeft[1]}
+			bottomRight := geom.Point{topRight[0], topRight[1] + yDiff}
+			bottomLeft := geom.Point{topLeft[0], topLeft[1] + yDiff}
+			area := Area{topLeft, topRight, bottomRight, bottomLeft}
+			areas = append(areas, area)
+		}
+	}
+
+	return areas
+}
+
+func (sb *SensorBeaconPair) scansArea(area Area) bool {
+	return sb.scansPoint(area[0]) &&
+		sb.scansPoint(area[1]) &&
+		sb.scansPoint(area[2]) &&
+		sb.scansPoint(area[3])
+}
+
+func (sb *SensorBeaconPair) scansPoint(point geom.Point) bool {
+	return sb.Area().includes(point)
+}
+
+func parseInput(lines []string) ([]SensorBeaconPair, error) {
+	sensorBeaconPairs := make([]SensorBeaconPair, 0)
+	for i, line := range lines {
+		var pair SensorBeaconPair
+		err := parse.Match("Sensor at x={int}, y={int}: closest beacon is at x={int}, y={int}", line,
+			&pair.sensor[0], &pair.sensor[1], &pair.beacon[0], &pair.beacon[1])
+		if err != nil {
+			return nil, parse.LineError(i+1, line, err)
+		}
+		sensorBeaconPairs = append(sensorBeaconPairs, pair)
+	}
+
+	return sensorBeaconPairs, nil
+}
+
+// radius is how far the sensor scans, out to its closest beacon
+func (sb *SensorBeaconPair) radius() int {
+	return geom.Manhattan(sb.sensor, sb.beacon)
+}
+
+// rowCoverage is the range the sensor scans in row y, and false if it doesn't
+// reach that row
+func (sb *SensorBeaconPair) rowCoverage(y int) (rng.Range, bool) {
+	halfWidth := sb.radius() - geom.Abs(sb.sensor[1]-y)
+	if halfWidth < 0 {
+		return rng.Range{}, false
+	}
+	return rng.New(sb.sensor[0]-halfWidth, sb.sensor[0]+halfWidth), true
+}
+
+func part1(sensorBeaconPairs []SensorBeaconPair) int {
+	var targetY int
+	if *util.UseSampleInput {
+		targetY = 10
+	} else {
+		targetY = 2000000
+	}
+
+	var covered rng.IntervalSet
+	for _, sb := range sensorBeaconPairs {
+		if r, ok := sb.rowCoverage(targetY); ok {
+			covered.Add(r)
+		}
+	}
+
+	// Make sure there's no actual beacons
+	for _, sb := range sensorBeaconPairs {
+		if sb.beacon[1] == targetY {
+			covered.Remove(rng.Range{sb.beacon[0], sb.beacon[0]})
+		}
+	}
+	return covered.Len()
+}
+
+func filterScannedOrOutOfBoundsAreas(sensorBeaconPairs *[]SensorBeaconPair, areasToScan *[]Area, minCoordinate, maxCoordinate int) []Area {
+	filteredAreasToScan := make([]Area, 0)
+	for _, area := range *areasToScan {
+		if area[1][0] < minCoordinate ||
+			area[0][0] > maxCoordinate ||
+			area[2][1] < minCoordinate ||
+			area[0][1] > maxCoordinate {
+			continue
+		}
+
+		areaIsScanned := false
+		for _, sb := range *sensorBeaconPairs {
+			if sb.scansArea(area) {
+				areaIsScanned = true
+				break
+			}
+		}
+
+		if !areaIsScanned {
+			filteredAreasToScan = append(filteredAreasToScan, area)
+		}
+	}
+	return filteredAreasToScan
+}
+
+func splitAreasToScan(areas *[]Area) []Area {
+	nextAreas := make([]Area, 0)
+	for _, area := range *areas {
+		topLeft := area[0]
+		topRight := area[1]
+		bottomRight := area[2]
+		bottomLeft := area[3]
+		absXDiff := geom.Abs(topLeft[0] - bottomRight[0])
+		xDiff := absXDiff / 2
+		absYDiff := geom.Abs(topLeft[1] - bottomRight[1])
+		yDiff := absYDiff / 2
+		topMiddleA := geom.Point{topLeft[0] + xDiff, topLeft[1]}
+		topMiddleB := geom.Point{topLeft[0] + xDiff + 1, topLeft[1]}
+		leftMiddleA := geom.Point{topLeft[0], topLeft[1] + yDiff}
+		leftMiddleB := geom.Point{topLeft[0], topLeft[1] + yDiff + 1}
+		rightMiddleA := geom.Point{topRight[0], topRight[1] + yDiff}
+		rightMiddleB := geom.Point{topRight[0], topRight[1] + yDiff + 1}
+		bottomMiddleA := geom.Point{topLeft[0] + xDiff, bottomLeft[1]}
+		bottomMiddleB := geom.Point{topLeft[0] + xDiff + 1, bottomLeft[1]}
+		middleMiddleA := geom.Point{topLeft[0] + xDiff, topLeft[1] + yDiff}
+		middleMiddleB := geom.Point{topLeft[0] + xDiff + 1, topLeft[1] + yDiff}
+		middleMiddleC := geom.Point{topLeft[0] + xDiff + 1, topLeft[1] + yDiff + 1}
+		middleMiddleD := geom.Point{topLeft[0] + xDiff, topLeft[1] + yDiff + 1}
+
+		if xDiff != 0 && yDiff != 0 {
+			q1 := Area{topLeft, topMiddleA, middleMiddleA, leftMiddleA}
+			q2 := Area{topMiddleB, topRight, rightMiddleA, middleMiddleB}
+			q3 := Area{middleMiddleC, rightMiddleB, bottomRight, bottomMiddleB}
+			q4 := Area{leftMiddleB, middleMiddleD, bottomMiddleA, bottomLeft}
+			nextAreas = append(nextAreas, q1, q2, q3, q4)
+			continue
+		}
+		if xDiff == 0 && yDiff != 0 {
+			top := Area{topLeft, topLeft, leftMiddleA, leftMiddleA}
+			bottom := Area{leftMiddleB, leftMiddleB, bottomLeft, bottomLeft}
+			nextAreas = append(nextAreas, top, bottom)
+			continue
+		}
+		if xDiff != 0 && yDiff == 0 {
+			left := Area{topLeft, topMiddleA, topMiddleA, topLeft}
+			right := Area{topMiddleB, topRight, topRight, topMiddleB}
+			nextAreas = append(nextAreas, left, right)
+			continue
+		}
+		nextAreas = append(nextAreas, area)
+	}
+	return nextAreas
+}
+
+func maxSize(areas *[]Area) int {
+	maxSize := math.MinInt
+	for _, area := range *areas {
+		size := area.size()
+		if size > maxSize {
+			maxSize = size
+		}
+	}
+	return maxSize
+}
+
+func part2(sensorBeaconPairs []SensorBeaconPair) (int, error) {
+	var minCoordinate = 0
+	var maxCoordinate int
+	if *util.UseSampleInput {
+		maxCoordinate = 20
+	} else {
+		maxCoordinate = 4000000
+	}
+
+	areasToScan := make([]Area, 0)
+	for _, sb := range sensorBeaconPairs {
+		areasToScan = append(areasToScan, sb.fringeAreas()...)
+	}
+
+	util.Debugln("Max size", maxSize(&areasToScan))
+	prevLen := 0
+	for len(areasToScan) != prevLen {
+		prevLen = len(areasToScan)
+		util.Debugln("Before filtering", len(areasToScan))
+		filteredAreasToScan := filterScannedOrOutOfBoundsAreas(&sensorBeaconPairs, &areasToScan, minCoordinate, maxCoordinate)
+		util.Debugln("After filtering", len(filteredAreasToScan))
+		areasToScan = splitAreasToScan(&filteredAreasToScan)
+		util.Debugln("After splitting", len(areasToScan))
+		util.Debugln("Max size", maxSize(&areasToScan))
+		util.Debugln("-")
+	}
+
+	util.Debugln("Split settled, scanning points in", len(areasToScan), "areas")
+
+	var distressPoint *geom.Point
+
+outer:
+	for _, area := range areasToScan {
+		for x := area[0][0]; x <= area[2][0]; x++ {
+			for y := area[0][1]; y <= area[2][1]; y++ {
+				point := geom.Point{x, y}
+				scanned := false
+				for _, sb := range sensorBeaconPairs {
+					if sb.scansPoint(point) {
+						scanned = true
+						break
+					}
+				}
+
+				if !scanned {
+					distressPoint = &point
+					break outer
+				}
+			}
+		}
+	}
+
+	if distressPoint == nil {
+		return 0, errors.New("Didn't find it, sorry")
+	}
+
+	util.Debugln(distressPoint)
+	return (distressPoint[0] * 4000000) + distressPoint[1], nil
+}
+
+type Solver struct {
+	sensorBeaconPairs []SensorBeaconPair
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	lines, err := util.ReadLines(r)
+	if err != nil {
+		return err
+	}
+	s.sensorBeaconPairs, err = parseInput(lines)
+	return err
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	return util.Int(part1(s.sensorBeaconPairs)), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	answer, err := part2(s.sensorBeaconPairs)
+	if err != nil {
+		return util.Answer{}, err
+	}
+	return util.Int(answer), nil
+}
@@ -0,0 +1,267 @@
+package part1
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/bitset"
+	"github.com/martin-nyaga/aoc-2022/util/parse"
+	"github.com/martin-nyaga/aoc-2022/util/search"
+	"github.com/martin-nyaga/aoc-2022/util/slices"
+)
+
+func init() {
+	util.Register(16, "part1", func() util.Solver { return &Solver{} })
+}
+
+type Valve struct {
+	// index is the valve's bit in the open set, or -1 if it's jammed and so
+	// never opened
+	index       int
+	name        string
+	rate        int
+	connections []string
+}
+
+type State struct {
+	currentValve string
+	openSet      bitset.Bits64
+	// rate is the pressure released each minute by the open valves
+	rate               int
+	valves             map[string]*Valve
+	tunnels            *search.Distances[string]
+	currentMinute      int
+	accumulatedRelease int
+	// path is the valves opened, in order
+	path []string
+}
+
+// contractTunnels is the distances between AA and the valves worth opening,
+// so the search can hop straight from one to the next
+func contractTunnels(valves map[string]*Valve) *search.Distances[string] {
+	names := make([]string, 0, len(valves))
+	for name := range valves {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	tunnels := search.Funcs[string]{NeighboursFunc: func(valve string) []string {
+		return valves[valve].connections
+	}}
+	worthOpening := []string{"AA"}
+	for _, name := range names {
+		if valves[name].rate > 0 && name != "AA" {
+			worthOpening = append(worthOpening, name)
+		}
+	}
+	return search.AllPairsBFS[string](tunnels, names).Contract(worthOpening)
+}
+
+func (state *State) isOpen(valveName string) bool {
+	return state.openSet.Has(state.valves[valveName].index)
+}
+
+func (state *State) releasePressure() {
+	state.accumulatedRelease += state.rate
+}
+
+func (state *State) nextStates() []State {
+	next := make([]State, 0)
+
+	if state.currentMinute == 30 {
+		return next
+	}
+
+	// If all non zero valves are open, just complete the simulation
+	allOpen := true
+	for _, valve := range state.valves {
+		if valve.rate > 0 && !state.isOpen(valve.name) {
+			allOpen = false
+		}
+	}
+	if allOpen {
+		minutesLeft := 30 - state.currentMinute
+		accumulatedRelease := state.accumulatedRelease + state.rate*minutesLeft
+		next = append(next, State{
+			currentValve:       state.currentValve,
+			openSet:            state.openSet,
+			rate:               state.rate,
+			valves:             state.valves,
+			tunnels:            state.tunnels,
+			currentMinute:      30,
+			accumulatedRelease: accumulatedRelease,
+			path:               state.path,
+		})
+		return next
+	}
+
+	// Try get to and open all valves I haven't opened
+	for _, name := range state.tunnels.Neighbours(state.currentValve) {
+		valve := state.valves[name]
+		if state.isOpen(valve.name) {
+			continue
+		}
+
+		// Don't bother opening jammed valves
+		if valve.rate == 0 {
+			continue
+		}
+
+		distance := state.tunnels.Cost(state.currentValve, valve.name)
+		// Don't bother opening valves which are too far to make a difference
+		if 30-state.currentMinute-distance < 2 {
+			continue
+		}
+
+		nextSet := state.openSet
+		nextSet.Add(valve.index)
+		accumulatedRelease := state.accumulatedRelease + state.rate*(distance+1)
+		nextPath := make([]string, 0)
+		nextPath = append(nextPath, state.path...)
+		nextPath = append(nextPath, valve.name)
+		next = append(next, State{
+			currentValve:       valve.name,
+			openSet:            nextSet,
+			rate:               state.rate + valve.rate,
+			valves:             state.valves,
+			tunnels:            state.tunnels,
+			currentMinute:      state.currentMinute + distance + 1,
+			accumulatedRelease: accumulatedRelease,
+			path:               nextPath,
+		})
+	}
+	if len(next) > 0 {
+		return next
+	}
+
+	// I haven't opened all valves, but can't get anywhere in reasonable time, so
+	// just simulate the remaining time
+	minutesLeft := 30 - state.currentMinute
+	accumulatedRelease := state.accumulatedRelease + state.rate*minutesLeft
+	next = append(next, State{
+		currentValve:       state.currentValve,
+		openSet:            state.openSet,
+		rate:               state.rate,
+		valves:             state.valves,
+		tunnels:            state.tunnels,
+		currentMinute:      30,
+		accumulatedRelease: accumulatedRelease,
+		path:               state.path,
+	})
+	return next
+}
+
+func parseInput(lines []string) (map[string]*Valve, error) {
+	valves := make(map[string]*Valve)
+	lineNos := make(map[string]int)
+	toOpen := 0
+	for i, line := range lines {
+		var valveName, tunnels, valve string
+		var intRate int
+		var connectedValves []string
+		err := parse.Match("Valve {word} has flow rate={int}; {any} to {word} {list}", line,
+			&valveName, &intRate, &tunnels, &valve, &connectedValves)
+		if err != nil {
+			return nil, parse.LineError(i+1, line, err)
+		}
+		if _, exists := valves[valveName]; exists {
+			return nil, &util.ParseError{Line: i + 1, Column: 7, Text: line, Err: fmt.Errorf("Duplicate valve %q", valveName)}
+		}
+		// Open valves are tracked by index in a single word, and only valves
+		// with a flow rate are worth opening
+		index := -1
+		if intRate > 0 {
+			if toOpen == 64 {
+				return nil, &util.ParseError{Line: i + 1, Text: line, Err: errors.New("Too many valves to open")}
+			}
+			index = toOpen
+			toOpen++
+		}
+		lineNos[valveName] = i + 1
+		valves[valveName] = &Valve{
+			index:       index,
+			name:        valveName,
+			rate:        intRate,
+			connections: connectedValves,
+		}
+	}
+
+	for name, valve := range valves {
+		for _, connection := range valve.connections {
+			if _, exists := valves[connection]; !exists {
+				line := lines[lineNos[name]-1]
+				return nil, &util.ParseError{Line: lineNos[name], Column: strings.LastIndex(line, connection) + 1, Text: line, Err: fmt.Errorf("Unknown valve %q", connection)}
+			}
+		}
+	}
+
+	return valves, nil
+}
+
+type Solver struct {
+	valves  map[string]*Valve
+	tunnels *search.Distances[string]
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	lines, err := util.ReadLines(r)
+	if err != nil {
+		return err
+	}
+	s.valves, err = parseInput(lines)
+	if err != nil {
+		return err
+	}
+	if _, exists := s.valves["AA"]; !exists {
+		return errors.New("No valve AA to start from")
+	}
+	s.tunnels = contractTunnels(s.valves)
+	return nil
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	state := State{currentValve: "AA", valves: s.valves, tunnels: s.tunnels}
+	queue := make([]State, 0)
+	queue = append(queue, state)
+
+	var bestState *State
+	for len(queue) > 0 {
+		state, err := slices.Shift(&queue)
+		if err != nil {
+			return util.Answer{}, err
+		}
+		util.Debugln(state.currentMinute)
+		if state.currentMinute == 30 && (bestState == nil || (state.accumulatedRelease > bestState.accumulatedRelease)) {
+			bestState = &state
+			util.Debugln("new best")
+			util.Debugln("minute:", state.currentMinute)
+			util.Debugln("path:", state.path)
+			util.Debugln("released:", state.accumulatedRelease)
+			opened := ""
+			for _, valve := range state.valves {
+				if state.isOpen(valve.name) {
+					opened += valve.name + ", "
+				}
+			}
+			util.Debugln("opened:", opened)
+			util.Debugln("---")
+
+			continue
+		}
+
+		nextStates := state.nextStates()
+		queue = append(queue, nextStates...)
+	}
+
+	if bestState == nil {
+		return util.Answer{}, errors.New("Couldn't find it, sorry")
+	}
+	return util.Int(bestState.accumulatedRelease), nil
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	return util.Answer{}, util.ErrUnsolved
+}
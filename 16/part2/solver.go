@@ -0,0 +1,498 @@
+package part2
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/bitset"
+	"github.com/martin-nyaga/aoc-2022/util/parse"
+	"github.com/martin-nyaga/aoc-2022/util/pqueue"
+	"github.com/martin-nyaga/aoc-2022/util/search"
+	"github.com/martin-nyaga/aoc-2022/util/set"
+)
+
+func init() {
+	util.Register(16, "part2", func() util.Solver { return &Solver{} })
+}
+
+type Valve struct {
+	// index is the valve's bit in the open set, or -1 if it's jammed and so
+	// never opened
+	index       int
+	name        string
+	rate        int
+	connections []string
+}
+
+type State struct {
+	actors  [2]Actor
+	openSet bitset.Bits64
+	// rate is the pressure released each minute by the open valves
+	rate               int
+	valves             map[string]*Valve
+	valvesToOpen       []string
+	currentMinute      int
+	accumulatedRelease int
+}
+
+// Priority orders states by the most pressure they could release, then the
+// latest minute so that finished states are found sooner
+type Priority = pqueue.Pair[int, int]
+
+// Actor heads straight for the next valve to open, and is busy until it has
+// got there and opened it
+type Actor struct {
+	name string
+	// path is the valves opened, in order
+	path         []string
+	currentValve string
+	// targetValve is the valve being headed for, empty if the actor has
+	// nothing to do
+	targetValve string
+	// stepsLeft is the minutes until the actor is free again
+	stepsLeft int
+}
+
+// Position is where an actor is and what it's doing, without how it got
+// there
+type Position struct {
+	currentValve string
+	targetValve  string
+	stepsLeft    int
+}
+
+func (p Position) less(other Position) bool {
+	if p.currentValve != other.currentValve {
+		return p.currentValve < other.currentValve
+	}
+	if p.targetValve != other.targetValve {
+		return p.targetValve < other.targetValve
+	}
+	return p.stepsLeft < other.stepsLeft
+}
+
+// Key identifies states which play out the same whatever led to them
+type Key struct {
+	openSet            bitset.Bits64
+	currentMinute      int
+	positions          [2]Position
+	accumulatedRelease int
+}
+
+// Tunnels has the distances between AA and the valves worth opening, worked
+// out up front so actors can hop straight from one to the next
+type Tunnels struct {
+	paths *search.Distances[string]
+}
+
+func newTunnels(valves map[string]*Valve, valvesToOpen []string) Tunnels {
+	names := make([]string, 0, len(valves))
+	for name := range valves {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	tunnels := search.Funcs[string]{NeighboursFunc: func(valve string) []string {
+		return valves[valve].connections
+	}}
+	worthOpening := []string{"AA"}
+	for _, name := range valvesToOpen {
+		if name != "AA" {
+			worthOpening = append(worthOpening, name)
+		}
+	}
+	return Tunnels{paths: search.AllPairsBFS[string](tunnels, names).Contract(worthOpening)}
+}
+
+// distance is the minutes it takes to walk from a to b, and false if b can't
+// be reached
+func (t *Tunnels) distance(a, b string) (int, bool) {
+	return t.paths.Distance(a, b)
+}
+
+func (state *State) isOpen(valveName string) bool {
+	return state.openSet.Has(state.valves[valveName].index)
+}
+
+func (state *State) accumulateRelease(minutes int) int {
+	return state.accumulatedRelease + state.rate*minutes
+}
+
+func (state *State) key() Key {
+	positions := [2]Position{}
+	for i, actor := range state.actors {
+		positions[i] = Position{
+			currentValve: actor.currentValve,
+			targetValve:  actor.targetValve,
+			stepsLeft:    actor.stepsLeft,
+		}
+	}
+	// It doesn't matter which actor is which
+	if positions[1].less(positions[0]) {
+		positions[0], positions[1] = positions[1], positions[0]
+	}
+	return Key{
+		openSet:            state.openSet,
+		currentMinute:      state.currentMinute,
+		positions:          positions,
+		accumulatedRelease: state.accumulatedRelease,
+	}
+}
+
+func (state *State) withActor(actorIndex int, actor Actor) State {
+	nextActors := state.actors
+	nextActors[actorIndex] = actor
+	return State{
+		actors:             nextActors,
+		openSet:            state.openSet,
+		rate:               state.rate,
+		valves:             state.valves,
+		valvesToOpen:       state.valvesToOpen,
+		currentMinute:      state.currentMinute,
+		accumulatedRelease: state.accumulatedRelease,
+	}
+}
+
+// sendActor has the actor go straight to the valve and open it, taking a
+// minute for each tunnel and one more to open it
+func (state *State) sendActor(actorIndex int, valveName string, distance int) State {
+	actor := state.actors[actorIndex]
+	actor.targetValve = valveName
+	actor.stepsLeft = distance + 1
+	return state.withActor(actorIndex, actor)
+}
+
+// retireActor has the actor stay put for the rest of the time, leaving the
+// remaining valves to the other
+func (state *State) retireActor(actorIndex int) State {
+	actor := state.actors[actorIndex]
+	actor.stepsLeft = 26 - state.currentMinute
+	return state.withActor(actorIndex, actor)
+}
+
+// simulateUntilAnActorIsFree moves time on until the next actor opens its
+// valve, or the time runs out
+func (state *State) simulateUntilAnActorIsFree() State {
+	steps := 26 - state.currentMinute
+	for _, actor := range state.actors {
+		if actor.stepsLeft < steps {
+			steps = actor.stepsLeft
+		}
+	}
+
+	nextSet := state.openSet
+	nextRate := state.rate
+	nextActors := [2]Actor{}
+	for i, actor := range state.actors {
+		actor.stepsLeft -= steps
+		if actor.stepsLeft == 0 && actor.targetValve != "" {
+			valve := state.valves[actor.targetValve]
+			nextSet.Add(valve.index)
+			nextRate += valve.rate
+			actor.path = append(append([]string{}, actor.path...), valve.name)
+			actor.currentValve = valve.name
+			actor.targetValve = ""
+		}
+		nextActors[i] = actor
+	}
+
+	return State{
+		actors:             nextActors,
+		openSet:            nextSet,
+		rate:               nextRate,
+		valves:             state.valves,
+		valvesToOpen:       state.valvesToOpen,
+		currentMinute:      state.currentMinute + steps,
+		accumulatedRelease: state.accumulateRelease(steps),
+	}
+}
+
+func (state *State) freeActor() (int, bool) {
+	for i, actor := range state.actors {
+		if actor.stepsLeft == 0 {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (state *State) DebugPrint() {
+	if !*util.Debug {
+		return
+	}
+
+	fmt.Println("---State---")
+	fmt.Println("CurrentMinute", state.currentMinute)
+	fmt.Println("accumulatedRelease", state.accumulatedRelease)
+	for _, actor := range state.actors {
+		fmt.Println(actor.name, "valve", actor.currentValve)
+		fmt.Println(actor.name, "path", actor.path)
+		fmt.Println(actor.name, "heading for", actor.targetValve, "free in", actor.stepsLeft)
+	}
+	opened := ""
+	for _, valve := range state.valves {
+		if state.isOpen(valve.name) {
+			opened += valve.name + ", "
+		}
+	}
+	fmt.Println("opened", opened)
+	fmt.Println("------")
+}
+
+func (state *State) ForcePrint() {
+	old := *util.Debug
+	defer func() { *util.Debug = old }()
+	*util.Debug = true
+	state.DebugPrint()
+}
+
+func (state *State) valveIsOpenOrClaimed(valveName string) bool {
+	if state.isOpen(valveName) {
+		return true
+	}
+	for _, actor := range state.actors {
+		if actor.targetValve == valveName {
+			return true
+		}
+	}
+	return false
+}
+
+func (state *State) nextStates(tunnels *Tunnels) []State {
+	util.Debugln("At state")
+	state.DebugPrint()
+
+	next := make([]State, 0)
+
+	if state.currentMinute == 26 {
+		util.Debugln("Ran out of time")
+		return next
+	}
+
+	// Let actors move until at least one has nothing to do
+	actorIndex, ok := state.freeActor()
+	if !ok {
+		next = append(next, state.simulateUntilAnActorIsFree())
+		return next
+	}
+
+	// Send the free actor to each valve it can still open in time, or have it
+	// stop there
+	actor := state.actors[actorIndex]
+	for _, valveName := range state.valvesToOpen {
+		if state.valveIsOpenOrClaimed(valveName) {
+			continue
+		}
+		distance, ok := tunnels.distance(actor.currentValve, valveName)
+		// Don't bother opening valves which are too far to make a difference
+		if !ok || 26-state.currentMinute-distance < 2 {
+			continue
+		}
+		util.Debugln(actor.name, "can go to", valveName)
+		next = append(next, state.sendActor(actorIndex, valveName, distance))
+	}
+	next = append(next, state.retireActor(actorIndex))
+	return next
+}
+
+// committedRelease is the pressure released by the end if the actors open the
+// valves they're heading for and nothing else
+func (state *State) committedRelease() int {
+	result := state.accumulateRelease(26 - state.currentMinute)
+	for _, actor := range state.actors {
+		if actor.targetValve != "" {
+			result += (26 - state.currentMinute - actor.stepsLeft) * state.valves[actor.targetValve].rate
+		}
+	}
+	return result
+}
+
+// bestCaseRelease is the most pressure that could be released by the end. It
+// has every valve nobody has claimed opened by whichever actor could get to
+// it first, as if they could be in many places at once, so it's never too low.
+func (state *State) bestCaseRelease(tunnels *Tunnels) int {
+	result := state.committedRelease()
+	for _, valveName := range state.valvesToOpen {
+		if state.valveIsOpenOrClaimed(valveName) {
+			continue
+		}
+		best := 0
+		for _, actor := range state.actors {
+			// Retired actors don't open anything else
+			if actor.targetValve == "" && actor.stepsLeft > 0 {
+				continue
+			}
+			from := actor.currentValve
+			if actor.targetValve != "" {
+				from = actor.targetValve
+			}
+			distance, ok := tunnels.distance(from, valveName)
+			if !ok {
+				continue
+			}
+			released := (26 - state.currentMinute - actor.stepsLeft - distance - 1) * state.valves[valveName].rate
+			if released > best {
+				best = released
+			}
+		}
+		result += best
+	}
+	return result
+}
+
+func parseInput(lines []string) (map[string]*Valve, []string, error) {
+	valves := make(map[string]*Valve)
+	valvesToOpen := make([]string, 0)
+	lineNos := make(map[string]int)
+	toOpen := 0
+	for i, line := range lines {
+		var valveName, tunnels, valve string
+		var intRate int
+		var connectedValves []string
+		err := parse.Match("Valve {word} has flow rate={int}; {any} to {word} {list}", line,
+			&valveName, &intRate, &tunnels, &valve, &connectedValves)
+		if err != nil {
+			return nil, nil, parse.LineError(i+1, line, err)
+		}
+		if _, exists := valves[valveName]; exists {
+			return nil, nil, &util.ParseError{Line: i + 1, Column: 7, Text: line, Err: fmt.Errorf("Duplicate valve %q", valveName)}
+		}
+		// Open valves are tracked by index in a single word, and only valves
+		// with a flow rate are worth opening
+		index := -1
+		if intRate > 0 {
+			if toOpen == 64 {
+				return nil, nil, &util.ParseError{Line: i + 1, Text: line, Err: errors.New("Too many valves to open")}
+			}
+			index = toOpen
+			toOpen++
+		}
+		lineNos[valveName] = i + 1
+		valves[valveName] = &Valve{
+			index:       index,
+			name:        valveName,
+			rate:        intRate,
+			connections: connectedValves,
+		}
+		if intRate > 0 {
+			valvesToOpen = append(valvesToOpen, valveName)
+		}
+	}
+
+	for name, valve := range valves {
+		for _, connection := range valve.connections {
+			if _, exists := valves[connection]; !exists {
+				line := lines[lineNos[name]-1]
+				return nil, nil, &util.ParseError{Line: lineNos[name], Column: strings.LastIndex(line, connection) + 1, Text: line, Err: fmt.Errorf("Unknown valve %q", connection)}
+			}
+		}
+	}
+
+	return valves, valvesToOpen, nil
+}
+
+type Solver struct {
+	valves       map[string]*Valve
+	valvesToOpen []string
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	lines, err := util.ReadLines(r)
+	if err != nil {
+		return err
+	}
+	s.valves, s.valvesToOpen, err = parseInput(lines)
+	if err != nil {
+		return err
+	}
+	if _, exists := s.valves["AA"]; !exists {
+		return errors.New("No valve AA to start from")
+	}
+	return nil
+}
+
+func (s *Solver) Part1() (util.Answer, error) {
+	return util.Answer{}, util.ErrUnsolved
+}
+
+func (s *Solver) Part2() (util.Answer, error) {
+	valves, valvesToOpen := s.valves, s.valvesToOpen
+	util.Debugln("Ordered valves", valvesToOpen)
+	tunnels := newTunnels(valves, valvesToOpen)
+	state := State{actors: [2]Actor{
+		{name: "me", currentValve: "AA", path: []string{}},
+		{name: "elephant", currentValve: "AA", path: []string{}},
+	}, valves: valves, valvesToOpen: valvesToOpen}
+	// Explore the highest possible release first, then the latest minute
+	queue := pqueue.NewPqueueFunc[Priority, State](pqueue.PairOrdering[int, int](pqueue.MaxQueue, pqueue.MaxQueue))
+	queue.Push(Priority{First: state.bestCaseRelease(&tunnels), Second: state.currentMinute}, state)
+	visited := set.NewSet[Key]()
+
+	var bestState *State
+	i := 0
+	for !queue.Empty() && i < *util.MaxIter {
+		i += 1
+		state, err := queue.Pop()
+		if err != nil {
+			return util.Answer{}, err
+		}
+
+		// Nothing left in the queue can beat the best so far
+		if bestState != nil && state.bestCaseRelease(&tunnels) <= bestState.accumulatedRelease {
+			break
+		}
+
+		key := state.key()
+		if visited.Has(key) {
+			util.Debugln("Visited!")
+			continue
+		}
+		visited.Add(key)
+
+		if i%10000 == 0 {
+			util.Debugln("Current state minute:", state.currentMinute)
+			util.Debugln("Visited states:", len(visited))
+			util.Debugln("Queue size:", queue.Len())
+			if bestState != nil {
+				util.Debugln("Best so far:", bestState.accumulatedRelease)
+			}
+			util.Debugln()
+		}
+		if state.currentMinute == 26 {
+			bestState = &state
+
+			util.Debugln("new best:", bestState.accumulatedRelease)
+			util.Debugln()
+
+			if *util.Debug {
+				util.Debugln("minute:", state.currentMinute)
+				util.Debugln("my path:", state.actors[0].path)
+				util.Debugln("elephant path:", state.actors[1].path)
+				util.Debugln("released:", state.accumulatedRelease)
+				util.Debugln("---")
+			}
+
+			continue
+		}
+
+		for _, nextState := range state.nextStates(&tunnels) {
+			releasable := nextState.bestCaseRelease(&tunnels)
+			util.Debugln("Releasable:", releasable)
+			if bestState != nil && releasable <= bestState.accumulatedRelease {
+				continue
+			}
+			queue.Push(Priority{First: releasable, Second: nextState.currentMinute}, nextState)
+		}
+	}
+
+	if bestState == nil {
+		return util.Answer{}, errors.New("Couldn't find it, sorry")
+	}
+
+	util.Debugln("Total visited", len(visited))
+	bestState.DebugPrint()
+	return util.Int(bestState.accumulatedRelease), nil
+}
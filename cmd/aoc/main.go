@@ -0,0 +1,548 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	_ "github.com/martin-nyaga/aoc-2022/days"
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/aoc"
+	"github.com/martin-nyaga/aoc-2022/util/scaffold"
+)
+
+var Part = flag.Int("part", 0, "Only run the given part (1 or 2)")
+var Variant = flag.String("variant", "", "Only run the given variant of a day's solution, e.g. golf")
+var Prof = flag.String("prof", "", "Generate cpu profile")
+var JSON = flag.Bool("json", false, "Print results as JSON")
+var Templates = flag.String("templates", "", "Directory of templates for new, overriding the built in ones")
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintln(out, "Usage:")
+	fmt.Fprintln(out, "  aoc run <day|all> [flags]")
+	fmt.Fprintln(out, "  aoc compare <day|all> [flags]")
+	fmt.Fprintln(out, "  aoc fetch <day>")
+	fmt.Fprintln(out, "  aoc submit <day> <part> [answer]")
+	fmt.Fprintln(out, "  aoc new <day> [-templates dir]")
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "The input file can also be set with %s\n", util.InputEnvVar)
+	fmt.Fprintf(out, "fetch and submit read the session cookie from %s or %s\n", aoc.SessionEnvVar, aoc.SessionFile)
+	fmt.Fprintln(out, "submit runs the solver for the answer unless one is given")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "Flags:")
+	flag.PrintDefaults()
+}
+
+// parseArgs parses flags which may be interleaved with positional arguments,
+// e.g. `run 15 --part 2 --sample`, returning the positional arguments
+func parseArgs(args []string) ([]string, error) {
+	positional := make([]string, 0)
+	for {
+		if err := flag.CommandLine.Parse(args); err != nil {
+			return nil, err
+		}
+		args = flag.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+	return positional, nil
+}
+
+func parts() []int {
+	if *Part == 0 {
+		return []int{1, 2}
+	}
+	return []int{*Part}
+}
+
+const (
+	exitFailed   = 1
+	exitUsage    = 2
+	exitBadInput = 3
+)
+
+// exitError carries the exit code for a failed command
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e exitError) Error() string {
+	return e.err.Error()
+}
+
+var stdin []byte
+
+type stdinReader struct {
+	*bytes.Reader
+}
+
+func (stdinReader) Name() string { return "<stdin>" }
+func (stdinReader) Close() error { return nil }
+
+// openInput opens the input for a solver. Stdin is buffered so that it can be
+// given to more than one variant.
+func openInput(registration util.Registration) (io.ReadCloser, error) {
+	if util.InputOverride() != "-" {
+		return util.NewInputFile(registration.Name()).Open()
+	}
+
+	if stdin == nil {
+		var err error
+		stdin, err = io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return stdinReader{bytes.NewReader(stdin)}, nil
+}
+
+// solve runs the given parts of a single solver against its input file
+func solve(registration util.Registration, parts []int) []util.Result {
+	file, err := openInput(registration)
+	if err != nil {
+		results := make([]util.Result, 0, len(parts))
+		for _, part := range parts {
+			results = append(results, util.Result{
+				Day:     registration.Day,
+				Variant: registration.Variant,
+				Part:    part,
+				Err:     err,
+			})
+		}
+		return results
+	}
+	defer file.Close()
+
+	return util.Solve(registration, file, parts...)
+}
+
+// solveDay runs every requested part of a day, using the first variant which
+// solves each part unless a variant was chosen explicitly
+func solveDay(day int) ([]util.Result, error) {
+	registrations := util.SolversFor(day)
+	if len(registrations) == 0 {
+		return nil, fmt.Errorf("No solver registered for day %d", day)
+	}
+
+	results := make([]util.Result, 0)
+	if *Variant != "" {
+		for _, registration := range registrations {
+			if registration.Variant == *Variant {
+				return solve(registration, parts()), nil
+			}
+		}
+		return nil, fmt.Errorf("Day %d has no %q variant", day, *Variant)
+	}
+
+	remaining := parts()
+	for _, registration := range registrations {
+		if len(remaining) == 0 {
+			break
+		}
+		solved := solve(registration, remaining)
+		results = append(results, solved...)
+
+		unsolved := make([]int, 0)
+	outer:
+		for _, part := range remaining {
+			for _, result := range solved {
+				if result.Part == part {
+					continue outer
+				}
+			}
+			unsolved = append(unsolved, part)
+		}
+		remaining = unsolved
+	}
+	return results, nil
+}
+
+func answer(result util.Result) string {
+	if result.Err != nil {
+		return "error"
+	}
+	return result.Answer.String()
+}
+
+func name(result util.Result) string {
+	return util.Registration{Day: result.Day, Variant: result.Variant}.Name()
+}
+
+func printResults(results []util.Result) error {
+	if *JSON {
+		return json.NewEncoder(os.Stdout).Encode(results)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DAY\tPART\tANSWER\tPARSE\tSOLVE\t")
+
+	var total time.Duration
+	multiline := make([]util.Result, 0)
+	for _, result := range results {
+		answer := answer(result)
+		if strings.Contains(answer, "\n") {
+			multiline = append(multiline, result)
+			answer = "(see below)"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t\n",
+			name(result),
+			result.Part,
+			answer,
+			result.ParseTime.Round(time.Microsecond),
+			result.SolveTime.Round(time.Microsecond),
+		)
+		total += result.SolveTime
+	}
+	fmt.Fprintf(w, "\t\t\t\t%s\t\n", total.Round(time.Microsecond))
+	w.Flush()
+
+	for _, result := range multiline {
+		fmt.Println()
+		fmt.Printf("%s part %d:\n", name(result), result.Part)
+		fmt.Print(answer(result))
+	}
+	return checkResults(results)
+}
+
+// checkResults prints the errors of any failed parts, returning an error with
+// the exit code to use. Problems with the input take precedence over a
+// solver failing.
+func checkResults(results []util.Result) error {
+	code := 0
+	reported := make(map[string]bool)
+	for _, result := range results {
+		if result.Err == nil {
+			continue
+		}
+
+		var parseErr *util.ParseError
+		var pathErr *fs.PathError
+		var message string
+		if errors.As(result.Err, &parseErr) {
+			message = parseErr.Details()
+			code = exitBadInput
+		} else {
+			message = result.Err.Error() + "\n"
+			if errors.As(result.Err, &pathErr) {
+				code = exitBadInput
+			} else if code == 0 {
+				code = exitFailed
+			}
+		}
+
+		// Parse errors are shared by every part, only report them once
+		if reported[name(result)+message] {
+			continue
+		}
+		reported[name(result)+message] = true
+		fmt.Fprintf(os.Stderr, "\n%s part %d failed: %s", name(result), result.Part, message)
+	}
+
+	if code != 0 {
+		return exitError{code, errors.New("Some parts failed")}
+	}
+	return nil
+}
+
+// selectDays parses the day argument, which is either a day number or all
+func selectDays(args []string) ([]int, error) {
+	if len(args) != 1 {
+		return nil, errors.New("Expected a single day, or all")
+	}
+
+	if *Part != 0 && *Part != 1 && *Part != 2 {
+		return nil, fmt.Errorf("Invalid part %d", *Part)
+	}
+
+	days := make([]int, 0)
+	if args[0] == "all" {
+		if util.InputOverride() != "" {
+			return nil, errors.New("An input file can only be given for a single day")
+		}
+		for _, registration := range util.Solvers() {
+			if len(days) == 0 || days[len(days)-1] != registration.Day {
+				days = append(days, registration.Day)
+			}
+		}
+		return days, nil
+	}
+
+	day, err := strconv.Atoi(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid day %q", args[0])
+	}
+	return append(days, day), nil
+}
+
+func run(args []string) error {
+	days, err := selectDays(args)
+	if err != nil {
+		return err
+	}
+
+	results := make([]util.Result, 0)
+	for _, day := range days {
+		dayResults, err := solveDay(day)
+		if err != nil {
+			return err
+		}
+		results = append(results, dayResults...)
+	}
+
+	return printResults(results)
+}
+
+// compare runs every variant of the selected days and checks that all
+// variants solving a part agree on the answer. Variants which fail are
+// reported as failures rather than disagreements.
+func compare(args []string) error {
+	days, err := selectDays(args)
+	if err != nil {
+		return err
+	}
+
+	results := make([]util.Result, 0)
+	mismatches := 0
+	for _, day := range days {
+		dayResults := make([]util.Result, 0)
+		for _, registration := range util.SolversFor(day) {
+			dayResults = append(dayResults, solve(registration, parts())...)
+		}
+
+		for i, result := range dayResults {
+			if result.Err != nil {
+				continue
+			}
+			for _, other := range dayResults[i+1:] {
+				if other.Err != nil {
+					continue
+				}
+				if result.Part == other.Part && !util.SameAnswer(result, other) {
+					fmt.Fprintf(os.Stderr, "%s disagrees with %s on part %d\n", name(result), name(other), result.Part)
+					mismatches += 1
+				}
+			}
+		}
+		results = append(results, dayResults...)
+	}
+
+	err = printResults(results)
+	if err != nil {
+		return err
+	}
+	if mismatches > 0 {
+		return exitError{exitFailed, errors.New("Variants disagree on some answers")}
+	}
+	return nil
+}
+
+// fetch downloads the input and example for a day, unless they've already
+// been downloaded
+func fetch(args []string) error {
+	if len(args) != 1 {
+		return errors.New("Expected a single day")
+	}
+	day, err := strconv.Atoi(args[0])
+	if err != nil || day < 1 || day > 25 {
+		return fmt.Errorf("Invalid day %q", args[0])
+	}
+
+	fetched, err := fetchDay(day)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitError{exitFailed, err}
+	}
+
+	if fetched.Input == "" && fetched.Sample == "" {
+		fmt.Printf("Already have the input for day %d\n", day)
+	}
+	for _, path := range []string{fetched.Input, fetched.Sample} {
+		if path != "" {
+			fmt.Println("Wrote", path)
+		}
+	}
+	return nil
+}
+
+func fetchDay(day int) (aoc.Fetched, error) {
+	session, err := aoc.LoadSession()
+	if err != nil {
+		return aoc.Fetched{}, err
+	}
+	return aoc.Fetch(aoc.NewClient(session), ".", day)
+}
+
+// computeAnswer runs a single part of a day for submitting
+func computeAnswer(day int, part int) (string, error) {
+	*Part = part
+	results, err := solveDay(day)
+	if err != nil {
+		return "", err
+	}
+	if len(results) == 0 {
+		return "", fmt.Errorf("Day %d part %d isn't solved yet", day, part)
+	}
+	if err := checkResults(results); err != nil {
+		return "", err
+	}
+
+	answer := results[0].Answer.String()
+	if strings.Contains(answer, "\n") {
+		fmt.Print(answer)
+		return "", exitError{exitFailed, errors.New("Can't submit a multi-line answer, read it off and pass it in")}
+	}
+	return answer, nil
+}
+
+// submit posts an answer, refusing answers which have already been rejected
+func submit(args []string) error {
+	if len(args) != 2 && len(args) != 3 {
+		return errors.New("Expected a day, a part and optionally an answer")
+	}
+	day, err := strconv.Atoi(args[0])
+	if err != nil || day < 1 || day > 25 {
+		return fmt.Errorf("Invalid day %q", args[0])
+	}
+	part, err := strconv.Atoi(args[1])
+	if err != nil || (part != 1 && part != 2) {
+		return fmt.Errorf("Invalid part %q", args[1])
+	}
+
+	var answer string
+	if len(args) == 3 {
+		answer = args[2]
+	} else if answer, err = computeAnswer(day, part); err != nil {
+		return err
+	}
+
+	response, history, err := submitAnswer(day, part, answer)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitError{exitFailed, err}
+	}
+
+	switch response.Verdict {
+	case aoc.Correct:
+		fmt.Printf("%s is the right answer!\n", answer)
+		return nil
+	case aoc.TooHigh, aoc.TooLow, aoc.Wrong:
+		fmt.Printf("%s is %s, %s\n", answer, response.Verdict, history.Bounds(part))
+	case aoc.Wait:
+		fmt.Printf("Submitted too recently, wait %s\n", response.Wait)
+	default:
+		fmt.Println(response.Message)
+	}
+	if response.Wait > 0 && response.Verdict != aoc.Wait {
+		fmt.Printf("Wait %s before trying again\n", response.Wait)
+	}
+	return exitError{exitFailed, errors.New("Answer not accepted")}
+}
+
+func submitAnswer(day int, part int, answer string) (aoc.Response, *aoc.History, error) {
+	session, err := aoc.LoadSession()
+	if err != nil {
+		return aoc.Response{}, nil, err
+	}
+	history, err := aoc.LoadHistory(aoc.HistoryPath(".", day))
+	if err != nil {
+		return aoc.Response{}, nil, err
+	}
+	response, err := aoc.SubmitAnswer(aoc.NewClient(session), history, day, part, answer)
+	return response, history, err
+}
+
+// newDay generates the skeleton for a day
+func newDay(args []string) error {
+	if len(args) != 1 {
+		return errors.New("Expected a single day")
+	}
+	day, err := strconv.Atoi(args[0])
+	if err != nil || day < 1 || day > 25 {
+		return fmt.Errorf("Invalid day %q", args[0])
+	}
+
+	var templates fs.FS
+	if *Templates != "" {
+		templates = os.DirFS(*Templates)
+	}
+	written, err := scaffold.Generate(".", day, templates)
+	for _, path := range written {
+		fmt.Println("Wrote", path)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitError{exitFailed, err}
+	}
+	return nil
+}
+
+func main() {
+	os.Exit(dispatch())
+}
+
+// dispatch runs the command and returns the exit code, leaving the exit to
+// main so deferred cleanup like stopping the profiler still happens
+func dispatch() int {
+	flag.Usage = usage
+	if len(os.Args) < 2 {
+		usage()
+		return exitUsage
+	}
+
+	command := os.Args[1]
+	args, err := parseArgs(os.Args[2:])
+	if err != nil {
+		return exitUsage
+	}
+
+	if *Prof != "" {
+		f, err := os.Create(*Prof)
+		util.HandleError(err)
+		defer f.Close()
+		pprof.StartCPUProfile(f)
+		defer pprof.StopCPUProfile()
+	}
+
+	switch command {
+	case "run":
+		err = run(args)
+	case "compare":
+		err = compare(args)
+	case "fetch":
+		err = fetch(args)
+	case "submit":
+		err = submit(args)
+	case "new":
+		err = newDay(args)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n", command)
+		usage()
+		return exitUsage
+	}
+
+	var exitErr exitError
+	if errors.As(err, &exitErr) {
+		return exitErr.code
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		usage()
+		return exitUsage
+	}
+	return 0
+}
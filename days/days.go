@@ -0,0 +1,27 @@
+// Package days imports every day's solution so that they register themselves
+// with util.Register
+package days
+
+import (
+	_ "github.com/martin-nyaga/aoc-2022/01"
+	_ "github.com/martin-nyaga/aoc-2022/02"
+	_ "github.com/martin-nyaga/aoc-2022/02/golf"
+	_ "github.com/martin-nyaga/aoc-2022/03"
+	_ "github.com/martin-nyaga/aoc-2022/04"
+	_ "github.com/martin-nyaga/aoc-2022/05"
+	_ "github.com/martin-nyaga/aoc-2022/06"
+	_ "github.com/martin-nyaga/aoc-2022/07"
+	_ "github.com/martin-nyaga/aoc-2022/08"
+	_ "github.com/martin-nyaga/aoc-2022/09"
+	_ "github.com/martin-nyaga/aoc-2022/10"
+	_ "github.com/martin-nyaga/aoc-2022/11/part1"
+	_ "github.com/martin-nyaga/aoc-2022/11/part2"
+	_ "github.com/martin-nyaga/aoc-2022/12/part1"
+	_ "github.com/martin-nyaga/aoc-2022/12/part2"
+	_ "github.com/martin-nyaga/aoc-2022/13"
+	_ "github.com/martin-nyaga/aoc-2022/14/part1"
+	_ "github.com/martin-nyaga/aoc-2022/14/part2"
+	_ "github.com/martin-nyaga/aoc-2022/15"
+	_ "github.com/martin-nyaga/aoc-2022/16/part1"
+	_ "github.com/martin-nyaga/aoc-2022/16/part2"
+)
@@ -0,0 +1,12 @@
+package days
+
+import (
+	"testing"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/golden"
+)
+
+func TestGoldenAnswers(t *testing.T) {
+	golden.Check(t, "..", util.Solvers())
+}
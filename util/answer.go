@@ -0,0 +1,56 @@
+package util
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+type AnswerKind int
+
+const (
+	NoAnswer AnswerKind = iota
+	IntAnswer
+	StringAnswer
+)
+
+// Answer is what a part produces: most days count something, but some spell
+// their answer out, e.g. the crates on top of day 5's stacks
+type Answer struct {
+	Kind AnswerKind
+	Int  int
+	Str  string
+}
+
+func Int(n int) Answer {
+	return Answer{Kind: IntAnswer, Int: n}
+}
+
+func Str(s string) Answer {
+	return Answer{Kind: StringAnswer, Str: s}
+}
+
+// String is the answer as it should be printed or submitted
+func (a Answer) String() string {
+	switch a.Kind {
+	case IntAnswer:
+		return strconv.Itoa(a.Int)
+	case StringAnswer:
+		return a.Str
+	}
+	return ""
+}
+
+// Equal reports whether both answers are of the same kind and value
+func (a Answer) Equal(b Answer) bool {
+	return a == b
+}
+
+func (a Answer) MarshalJSON() ([]byte, error) {
+	switch a.Kind {
+	case IntAnswer:
+		return json.Marshal(a.Int)
+	case StringAnswer:
+		return json.Marshal(a.Str)
+	}
+	return []byte("null"), nil
+}
@@ -0,0 +1,24 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnswerEqual(t *testing.T) {
+	assert.True(t, Int(42).Equal(Int(42)))
+	assert.False(t, Int(42).Equal(Int(43)))
+	assert.False(t, Int(42).Equal(Str("42")))
+	assert.True(t, Str("ABC").Equal(Str("ABC")))
+	assert.False(t, Answer{}.Equal(Int(0)))
+}
+
+func TestAnswerJSON(t *testing.T) {
+	bytes, err := json.Marshal([]Result{{Day: 1, Part: 1, Answer: Int(42)}, {Day: 5, Part: 1, Answer: Str("CMZ")}, {Day: 7, Part: 2}})
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"day":1,"part":1,"answer":42,"parse_ns":0,"solve_ns":0},`+
+		`{"day":5,"part":1,"answer":"CMZ","parse_ns":0,"solve_ns":0},`+
+		`{"day":7,"part":2,"parse_ns":0,"solve_ns":0}]`, string(bytes))
+}
@@ -0,0 +1,204 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+	Year           = 2022
+
+	// SessionEnvVar holds the session cookie, otherwise it's read from
+	// SessionFile
+	SessionEnvVar = "AOC_SESSION"
+	SessionFile   = ".session"
+
+	// DefaultMinInterval is the shortest time allowed between two requests
+	DefaultMinInterval = 3 * time.Second
+	// LastRequestFile keeps the time of the last request, so that the
+	// interval holds across separate runs too
+	LastRequestFile = ".last_request"
+
+	userAgent = "github.com/martin-nyaga/aoc-2022 by martin-nyaga"
+)
+
+var ErrNoSession = fmt.Errorf("No session cookie, set %s or save it in %s", SessionEnvVar, SessionFile)
+
+// StatusError is returned when the server responds with anything but 200 OK
+type StatusError struct {
+	URL        string
+	StatusCode int
+	// RetryAfter is set when the server asks us to slow down
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	message := fmt.Sprintf("%s: %d %s", e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+	switch e.StatusCode {
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusInternalServerError:
+		message += " (is the session cookie still valid?)"
+	case http.StatusNotFound:
+		message += " (is the puzzle unlocked yet?)"
+	case http.StatusTooManyRequests:
+		message += fmt.Sprintf(" (try again in %s)", e.RetryAfter)
+	}
+	return message
+}
+
+// Client talks to the Advent of Code website, waiting at least MinInterval
+// between requests
+type Client struct {
+	BaseURL     string
+	Session     string
+	MinInterval time.Duration
+	HTTP        *http.Client
+	// LastRequestPath is where the time of the last request is saved between
+	// runs, which is skipped if it's empty
+	LastRequestPath string
+
+	mu          sync.Mutex
+	lastRequest time.Time
+	now         func() time.Time
+	sleep       func(time.Duration)
+}
+
+func NewClient(session string) *Client {
+	return &Client{
+		BaseURL:         DefaultBaseURL,
+		Session:         session,
+		MinInterval:     DefaultMinInterval,
+		HTTP:            &http.Client{Timeout: 30 * time.Second},
+		LastRequestPath: LastRequestFile,
+		now:             time.Now,
+		sleep:           time.Sleep,
+	}
+}
+
+// LoadSession finds the session cookie in the environment or SessionFile
+func LoadSession() (string, error) {
+	if session := os.Getenv(SessionEnvVar); session != "" {
+		return strings.TrimSpace(session), nil
+	}
+
+	contents, err := os.ReadFile(SessionFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNoSession
+	}
+	if err != nil {
+		return "", err
+	}
+	session := strings.TrimSpace(string(contents))
+	if session == "" {
+		return "", ErrNoSession
+	}
+	return session, nil
+}
+
+func (c *Client) dayURL(day int) string {
+	return fmt.Sprintf("%s/%d/day/%d", strings.TrimRight(c.BaseURL, "/"), Year, day)
+}
+
+// wait blocks until MinInterval has passed since the last request, whether
+// this client or an earlier run made it
+func (c *Client) wait() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	last := c.lastRequest
+	if saved, ok := c.savedLastRequest(); ok && saved.After(last) {
+		last = saved
+	}
+	if !last.IsZero() {
+		if elapsed := c.now().Sub(last); elapsed < c.MinInterval {
+			c.sleep(c.MinInterval - elapsed)
+		}
+	}
+	c.lastRequest = c.now()
+	c.saveLastRequest()
+}
+
+// savedLastRequest reads the time saved in LastRequestPath, and false if
+// there isn't one
+func (c *Client) savedLastRequest() (time.Time, bool) {
+	if c.LastRequestPath == "" {
+		return time.Time{}, false
+	}
+	contents, err := os.ReadFile(c.LastRequestPath)
+	if err != nil {
+		return time.Time{}, false
+	}
+	last, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(string(contents)))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return last, true
+}
+
+// saveLastRequest is best effort, since a request shouldn't fail just because
+// the time couldn't be saved
+func (c *Client) saveLastRequest() {
+	if c.LastRequestPath == "" {
+		return
+	}
+	os.WriteFile(c.LastRequestPath, []byte(c.lastRequest.Format(time.RFC3339Nano)+"\n"), 0600)
+}
+
+func (c *Client) do(method, target string, body io.Reader) ([]byte, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+
+	req, err := http.NewRequest(method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	c.wait()
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		statusErr := &StatusError{URL: target, StatusCode: resp.StatusCode}
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			statusErr.RetryAfter = time.Duration(seconds) * time.Second
+		}
+		return nil, statusErr
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (c *Client) get(target string) ([]byte, error) {
+	return c.do(http.MethodGet, target, nil)
+}
+
+func (c *Client) post(target string, form url.Values) ([]byte, error) {
+	return c.do(http.MethodPost, target, strings.NewReader(form.Encode()))
+}
+
+// Input downloads the puzzle input for a day
+func (c *Client) Input(day int) ([]byte, error) {
+	return c.get(c.dayURL(day) + "/input")
+}
+
+// Puzzle downloads the HTML puzzle page for a day
+func (c *Client) Puzzle(day int) (string, error) {
+	page, err := c.get(c.dayURL(day))
+	return string(page), err
+}
@@ -0,0 +1,237 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubServer stands in for adventofcode.com, serving canned pages for day 1
+// and counting requests by path
+func stubServer(t *testing.T) (*httptest.Server, map[string]int) {
+	page, err := os.ReadFile("testdata/day01.html")
+	assert.Nil(t, err)
+
+	requests := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path] += 1
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "secret" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/2022/day/1":
+			w.Write(page)
+		case "/2022/day/1/input":
+			w.Write([]byte("1\n2\n\n3\n"))
+		case "/2022/day/1/answer":
+			answerPage(w, r)
+		case "/2022/day/2/input":
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, requests
+}
+
+// answerPage responds like the real site to answers for day 1, where part 1
+// is 42 and part 2 has already been solved
+func answerPage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var message string
+	answer, err := strconv.Atoi(r.PostFormValue("answer"))
+	switch {
+	case r.PostFormValue("answer") == "spam":
+		message = "You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 5s left to wait."
+	case r.PostFormValue("level") == "2":
+		message = "You don't seem to be solving the right level.  Did you already complete it?"
+	case err != nil:
+		message = "That's not the right answer.  If you're stuck, make sure you're using the full input data."
+	case answer > 42:
+		message = "That's not the right answer; your answer is too high.  Please wait one minute before trying again."
+	case answer < 42:
+		message = "That's not the right answer; your answer is <em>too low</em>.  Please wait one minute before trying again."
+	default:
+		message = "That's the right answer!  You are <span class=\"day-success\">one gold star</span> closer to collecting enough star fruit."
+	}
+	fmt.Fprintf(w, "<html><body><main>\n<article><p>%s</p></article>\n</main></body></html>", message)
+}
+
+func testClient(server *httptest.Server, session string) *Client {
+	c := NewClient(session)
+	c.BaseURL = server.URL
+	c.MinInterval = 0
+	c.LastRequestPath = ""
+	return c
+}
+
+func TestExample(t *testing.T) {
+	page, err := os.ReadFile("testdata/day01.html")
+	assert.Nil(t, err)
+
+	example, err := Example(string(page))
+	assert.Nil(t, err)
+	assert.Equal(t, "1000\n2000\n3000\n\n4000\n\n5000\n6000\n", example)
+
+	example, err = Example("<pre><code>a &amp; b</code></pre>")
+	assert.Nil(t, err)
+	assert.Equal(t, "a & b", example)
+
+	_, err = Example("<p>No code here</p>")
+	assert.NotNil(t, err)
+}
+
+func TestFetch(t *testing.T) {
+	server, requests := stubServer(t)
+	root := t.TempDir()
+	c := testClient(server, "secret")
+
+	fetched, err := Fetch(c, root, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(root, "01", "input.txt"), fetched.Input)
+	assert.Equal(t, filepath.Join(root, "01", "sample.txt"), fetched.Sample)
+
+	input, err := os.ReadFile(fetched.Input)
+	assert.Nil(t, err)
+	assert.Equal(t, "1\n2\n\n3\n", string(input))
+	sample, err := os.ReadFile(fetched.Sample)
+	assert.Nil(t, err)
+	assert.Equal(t, "1000\n2000\n3000\n\n4000\n\n5000\n6000\n", string(sample))
+
+	fetched, err = Fetch(c, root, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, Fetched{}, fetched)
+	assert.Equal(t, map[string]int{"/2022/day/1": 1, "/2022/day/1/input": 1}, requests)
+}
+
+func TestFetchKeepsExistingFiles(t *testing.T) {
+	server, requests := stubServer(t)
+	root := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(root, "01"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(root, "01", "sample.txt"), []byte("mine"), 0644))
+
+	fetched, err := Fetch(testClient(server, "secret"), root, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "", fetched.Sample)
+	sample, err := os.ReadFile(filepath.Join(root, "01", "sample.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, "mine", string(sample))
+	assert.Equal(t, 0, requests["/2022/day/1"])
+}
+
+func TestFetchFillsEmptyFiles(t *testing.T) {
+	server, requests := stubServer(t)
+	root := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(root, "01"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(root, "01", "sample.txt"), []byte{}, 0644))
+
+	fetched, err := Fetch(testClient(server, "secret"), root, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(root, "01", "sample.txt"), fetched.Sample)
+	sample, err := os.ReadFile(fetched.Sample)
+	assert.Nil(t, err)
+	assert.Equal(t, "1000\n2000\n3000\n\n4000\n\n5000\n6000\n", string(sample))
+	assert.Equal(t, 1, requests["/2022/day/1"])
+}
+
+func TestFetchErrors(t *testing.T) {
+	server, _ := stubServer(t)
+	root := t.TempDir()
+
+	_, err := Fetch(testClient(server, ""), root, 1)
+	assert.True(t, errors.Is(err, ErrNoSession))
+
+	var statusErr *StatusError
+	_, err = Fetch(testClient(server, "wrong"), root, 1)
+	assert.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusBadRequest, statusErr.StatusCode)
+
+	_, err = Fetch(testClient(server, "secret"), root, 2)
+	assert.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusTooManyRequests, statusErr.StatusCode)
+	assert.Equal(t, 60*time.Second, statusErr.RetryAfter)
+
+	_, err = Fetch(testClient(server, "secret"), root, 25)
+	assert.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+	_, err = os.Stat(filepath.Join(root, "25", "input.txt"))
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestClientRateLimit(t *testing.T) {
+	server, _ := stubServer(t)
+	c := testClient(server, "secret")
+	c.MinInterval = 5 * time.Second
+
+	clock := time.Date(2022, 12, 1, 5, 0, 0, 0, time.UTC)
+	slept := make([]time.Duration, 0)
+	c.now = func() time.Time { return clock }
+	c.sleep = func(d time.Duration) {
+		slept = append(slept, d)
+		clock = clock.Add(d)
+	}
+
+	_, err := c.Input(1)
+	assert.Nil(t, err)
+	clock = clock.Add(2 * time.Second)
+	_, err = c.Puzzle(1)
+	assert.Nil(t, err)
+	clock = clock.Add(10 * time.Second)
+	_, err = c.Input(1)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []time.Duration{3 * time.Second}, slept)
+}
+
+func TestClientRateLimitAcrossRuns(t *testing.T) {
+	server, _ := stubServer(t)
+	path := filepath.Join(t.TempDir(), LastRequestFile)
+	clock := time.Date(2022, 12, 1, 5, 0, 0, 0, time.UTC)
+	slept := make([]time.Duration, 0)
+
+	// Each run gets a fresh client, as each aoc command does
+	run := func() {
+		c := testClient(server, "secret")
+		c.MinInterval = 5 * time.Second
+		c.LastRequestPath = path
+		c.now = func() time.Time { return clock }
+		c.sleep = func(d time.Duration) {
+			slept = append(slept, d)
+			clock = clock.Add(d)
+		}
+		_, err := c.Input(1)
+		assert.Nil(t, err)
+	}
+
+	run()
+	clock = clock.Add(1 * time.Second)
+	run()
+	clock = clock.Add(10 * time.Second)
+	run()
+
+	assert.Equal(t, []time.Duration{4 * time.Second}, slept)
+}
+
+func TestLoadSession(t *testing.T) {
+	t.Setenv(SessionEnvVar, " abc\n")
+	session, err := LoadSession()
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", session)
+}
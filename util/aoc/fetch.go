@@ -0,0 +1,84 @@
+package aoc
+
+import (
+	"errors"
+	"html"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+)
+
+var codeBlock = regexp.MustCompile(`(?s)<pre><code>(.*?)</code></pre>`)
+var tag = regexp.MustCompile(`<[^>]*>`)
+
+// Example extracts the example input from a puzzle page. That's the first
+// code block after "For example", or failing that the first one on the page.
+func Example(page string) (string, error) {
+	start := strings.Index(page, "For example")
+	if start == -1 || codeBlock.FindStringIndex(page[start:]) == nil {
+		start = 0
+	}
+
+	match := codeBlock.FindStringSubmatch(page[start:])
+	if match == nil {
+		return "", errors.New("Couldn't find an example on the puzzle page")
+	}
+	return html.UnescapeString(tag.ReplaceAllString(match[1], "")), nil
+}
+
+// Fetched lists the files written by Fetch
+type Fetched struct {
+	Input  string
+	Sample string
+}
+
+// Fetch downloads the input and example for a day into its directory under
+// root, skipping any file which is already there. Empty files count as
+// missing, like the sample.txt aoc new leaves to be filled in.
+func Fetch(c *Client, root string, day int) (Fetched, error) {
+	var fetched Fetched
+	dir := filepath.Join(root, util.DayDir(day))
+	inputPath := filepath.Join(dir, "input.txt")
+	samplePath := filepath.Join(dir, "sample.txt")
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fetched, err
+	}
+
+	if isEmpty(inputPath) {
+		input, err := c.Input(day)
+		if err != nil {
+			return fetched, err
+		}
+		if err := os.WriteFile(inputPath, input, 0644); err != nil {
+			return fetched, err
+		}
+		fetched.Input = inputPath
+	}
+
+	if isEmpty(samplePath) {
+		page, err := c.Puzzle(day)
+		if err != nil {
+			return fetched, err
+		}
+		example, err := Example(page)
+		if err != nil {
+			return fetched, err
+		}
+		if err := os.WriteFile(samplePath, []byte(example), 0644); err != nil {
+			return fetched, err
+		}
+		fetched.Sample = samplePath
+	}
+
+	return fetched, nil
+}
+
+// isEmpty is true if the file doesn't exist or has nothing in it
+func isEmpty(path string) bool {
+	info, err := os.Stat(path)
+	return err != nil || info.Size() == 0
+}
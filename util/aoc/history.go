@@ -0,0 +1,155 @@
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+)
+
+// HistoryFile is kept in each day's directory
+const HistoryFile = "submissions.json"
+
+type Attempt struct {
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	Time    time.Time `json:"time"`
+}
+
+// History records every answer submitted for a day
+type History struct {
+	path     string
+	Attempts []Attempt
+}
+
+// Bounds are the closest too high and too low answers submitted for a part
+type Bounds struct {
+	Low, High       int
+	HasLow, HasHigh bool
+}
+
+func (b Bounds) String() string {
+	low, high := "?", "?"
+	if b.HasLow {
+		low = strconv.Itoa(b.Low)
+	}
+	if b.HasHigh {
+		high = strconv.Itoa(b.High)
+	}
+	return fmt.Sprintf("%s < answer < %s", low, high)
+}
+
+// RejectedError explains why an answer wasn't submitted
+type RejectedError struct {
+	Answer string
+	Reason string
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("Not submitting %s: %s", e.Answer, e.Reason)
+}
+
+func HistoryPath(root string, day int) string {
+	return filepath.Join(root, util.DayDir(day), HistoryFile)
+}
+
+// LoadHistory reads the history at path, which is empty if the file doesn't
+// exist yet
+func LoadHistory(path string) (*History, error) {
+	history := &History{path: path, Attempts: make([]Attempt, 0)}
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return history, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(contents, &history.Attempts); err != nil {
+		return nil, fmt.Errorf("Couldn't read %s: %w", path, err)
+	}
+	return history, nil
+}
+
+func (h *History) Save() error {
+	contents, err := json.MarshalIndent(h.Attempts, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(h.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(h.path, append(contents, '\n'), 0644)
+}
+
+func (h *History) Record(attempt Attempt) {
+	h.Attempts = append(h.Attempts, attempt)
+}
+
+func (h *History) Bounds(part int) Bounds {
+	var bounds Bounds
+	for _, attempt := range h.Attempts {
+		n, err := strconv.Atoi(attempt.Answer)
+		if attempt.Part != part || err != nil {
+			continue
+		}
+		switch attempt.Verdict {
+		case TooLow:
+			if !bounds.HasLow || n > bounds.Low {
+				bounds.Low, bounds.HasLow = n, true
+			}
+		case TooHigh:
+			if !bounds.HasHigh || n < bounds.High {
+				bounds.High, bounds.HasHigh = n, true
+			}
+		}
+	}
+	return bounds
+}
+
+// Check returns a RejectedError if the answer is already known to be wrong,
+// or the part has already been solved
+func (h *History) Check(part int, answer string) error {
+	for _, attempt := range h.Attempts {
+		if attempt.Part != part {
+			continue
+		}
+		switch attempt.Verdict {
+		case Correct:
+			return &RejectedError{answer, fmt.Sprintf("part %d was already solved with %s", part, attempt.Answer)}
+		case TooHigh, TooLow, Wrong:
+			if attempt.Answer == answer {
+				return &RejectedError{answer, fmt.Sprintf("it was already rejected as %s", attempt.Verdict)}
+			}
+		}
+	}
+
+	bounds := h.Bounds(part)
+	if n, err := strconv.Atoi(answer); err == nil {
+		if (bounds.HasLow && n <= bounds.Low) || (bounds.HasHigh && n >= bounds.High) {
+			return &RejectedError{answer, fmt.Sprintf("it's outside the known bounds %s", bounds)}
+		}
+	}
+	return nil
+}
+
+// SubmitAnswer submits an answer unless the history shows it's wrong, and
+// records the outcome
+func SubmitAnswer(c *Client, h *History, day int, part int, answer string) (Response, error) {
+	if err := h.Check(part, answer); err != nil {
+		return Response{}, err
+	}
+
+	response, err := c.Submit(day, part, answer)
+	if err != nil {
+		return Response{}, err
+	}
+
+	h.Record(Attempt{Part: part, Answer: answer, Verdict: response.Verdict, Time: c.now().UTC()})
+	return response, h.Save()
+}
@@ -0,0 +1,92 @@
+package aoc
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Verdict string
+
+const (
+	Correct Verdict = "correct"
+	TooHigh Verdict = "too high"
+	TooLow  Verdict = "too low"
+	// Wrong is an incorrect answer without a hint
+	Wrong Verdict = "wrong"
+	// Wait means an answer was submitted too recently, nothing was checked
+	Wait Verdict = "wait"
+	// AlreadySolved means the part was already completed
+	AlreadySolved Verdict = "already solved"
+	Unknown       Verdict = "unknown"
+)
+
+// Response is the outcome of submitting an answer
+type Response struct {
+	Verdict Verdict
+	// Wait is how long to wait before submitting again, if known
+	Wait    time.Duration
+	Message string
+}
+
+var article = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+var waitTime = regexp.MustCompile(`(?:(\d+)m\s*)?(\d+)s left to wait`)
+var waitMinutes = regexp.MustCompile(`[Pp]lease wait (\w+) minutes?`)
+
+var numberWords = map[string]int{"one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "ten": 10}
+
+// ParseResponse works out the verdict from the page returned after
+// submitting an answer
+func ParseResponse(page string) Response {
+	message := page
+	if match := article.FindStringSubmatch(page); match != nil {
+		message = match[1]
+	}
+	message = strings.Join(strings.Fields(html.UnescapeString(tag.ReplaceAllString(message, ""))), " ")
+
+	response := Response{Verdict: Unknown, Message: message}
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		response.Verdict = Correct
+	case strings.Contains(message, "your answer is too high"):
+		response.Verdict = TooHigh
+	case strings.Contains(message, "your answer is too low"):
+		response.Verdict = TooLow
+	case strings.Contains(message, "That's not the right answer"):
+		response.Verdict = Wrong
+	case strings.Contains(message, "You gave an answer too recently"):
+		response.Verdict = Wait
+	case strings.Contains(message, "Did you already complete it"):
+		response.Verdict = AlreadySolved
+	}
+
+	if match := waitTime.FindStringSubmatch(message); match != nil {
+		minutes, _ := strconv.Atoi(match[1])
+		seconds, _ := strconv.Atoi(match[2])
+		response.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if match := waitMinutes.FindStringSubmatch(message); match != nil {
+		minutes, err := strconv.Atoi(match[1])
+		if err != nil {
+			minutes = numberWords[match[1]]
+		}
+		response.Wait = time.Duration(minutes) * time.Minute
+	}
+	return response
+}
+
+// Submit posts an answer for a part of a day
+func (c *Client) Submit(day int, part int, answer string) (Response, error) {
+	form := url.Values{}
+	form.Set("level", strconv.Itoa(part))
+	form.Set("answer", answer)
+
+	page, err := c.post(fmt.Sprintf("%s/answer", c.dayURL(day)), form)
+	if err != nil {
+		return Response{}, err
+	}
+	return ParseResponse(string(page)), nil
+}
@@ -0,0 +1,95 @@
+package aoc
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseResponse(t *testing.T) {
+	response := ParseResponse("<article><p>That's not the right answer; your answer is too high.  Please wait one minute before trying again.</p></article>")
+	assert.Equal(t, TooHigh, response.Verdict)
+	assert.Equal(t, time.Minute, response.Wait)
+
+	response = ParseResponse("<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 34s left to wait.</p></article>")
+	assert.Equal(t, Wait, response.Verdict)
+	assert.Equal(t, 34*time.Second, response.Wait)
+
+	response = ParseResponse("<article><p>That's not the right answer.  Please wait 5 minutes before trying again.</p></article>")
+	assert.Equal(t, Wrong, response.Verdict)
+	assert.Equal(t, 5*time.Minute, response.Wait)
+
+	response = ParseResponse("<html><body>Something else entirely</body></html>")
+	assert.Equal(t, Unknown, response.Verdict)
+	assert.Equal(t, "Something else entirely", response.Message)
+}
+
+func TestSubmit(t *testing.T) {
+	server, _ := stubServer(t)
+	c := testClient(server, "secret")
+
+	for answer, verdict := range map[string]Verdict{"42": Correct, "50": TooHigh, "3": TooLow, "abc": Wrong} {
+		response, err := c.Submit(1, 1, answer)
+		assert.Nil(t, err)
+		assert.Equal(t, verdict, response.Verdict, answer)
+	}
+
+	response, err := c.Submit(1, 1, "spam")
+	assert.Nil(t, err)
+	assert.Equal(t, Wait, response.Verdict)
+	assert.Equal(t, 65*time.Second, response.Wait)
+
+	response, err = c.Submit(1, 2, "42")
+	assert.Nil(t, err)
+	assert.Equal(t, AlreadySolved, response.Verdict)
+}
+
+func TestSubmitAnswerKeepsHistory(t *testing.T) {
+	server, requests := stubServer(t)
+	c := testClient(server, "secret")
+	path := HistoryPath(t.TempDir(), 1)
+
+	submit := func(answer string) (Response, error) {
+		history, err := LoadHistory(path)
+		assert.Nil(t, err)
+		return SubmitAnswer(c, history, 1, 1, answer)
+	}
+
+	response, err := submit("60")
+	assert.Nil(t, err)
+	assert.Equal(t, TooHigh, response.Verdict)
+	response, err = submit("10")
+	assert.Nil(t, err)
+	assert.Equal(t, TooLow, response.Verdict)
+	response, err = submit("50")
+	assert.Nil(t, err)
+	assert.Equal(t, TooHigh, response.Verdict)
+
+	var rejected *RejectedError
+	for _, answer := range []string{"60", "55", "50", "10", "5"} {
+		_, err = submit(answer)
+		assert.True(t, errors.As(err, &rejected), answer)
+	}
+	assert.Equal(t, 3, requests["/2022/day/1/answer"])
+
+	history, err := LoadHistory(path)
+	assert.Nil(t, err)
+	assert.Equal(t, Bounds{Low: 10, High: 50, HasLow: true, HasHigh: true}, history.Bounds(1))
+	assert.Equal(t, "10 < answer < 50", history.Bounds(1).String())
+	assert.Equal(t, "? < answer < ?", history.Bounds(2).String())
+
+	response, err = submit("42")
+	assert.Nil(t, err)
+	assert.Equal(t, Correct, response.Verdict)
+	_, err = submit("43")
+	assert.True(t, errors.As(err, &rejected))
+	assert.Contains(t, err.Error(), "already solved with 42")
+
+	history, err = LoadHistory(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(history.Attempts))
+	assert.Equal(t, filepath.Join("01", HistoryFile), filepath.Join(filepath.Base(filepath.Dir(path)), filepath.Base(path)))
+}
@@ -0,0 +1,174 @@
+package bitset
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/martin-nyaga/aoc-2022/util/hash"
+)
+
+// Bits64 is a set of the integers 0 to 63 packed into a single word. It's a
+// plain value, so sets can be compared with == and used as map keys.
+type Bits64 uint64
+
+func New64(elements ...int) Bits64 {
+	var b Bits64
+	for _, el := range elements {
+		b.Add(el)
+	}
+	return b
+}
+
+func (b *Bits64) Add(el int) {
+	if el < 0 || el >= 64 {
+		panic(fmt.Errorf("%d doesn't fit in a Bits64", el))
+	}
+	*b |= 1 << el
+}
+
+func (b Bits64) Has(el int) bool {
+	return el >= 0 && el < 64 && b&(1<<el) != 0
+}
+
+func (b Bits64) Empty() bool {
+	return b == 0
+}
+
+// Len is the number of elements in the set, its popcount
+func (b Bits64) Len() int {
+	return bits.OnesCount64(uint64(b))
+}
+
+func (b *Bits64) Remove(el int) error {
+	if !b.Has(el) {
+		return fmt.Errorf("Set doesn't have %d", el)
+	}
+	*b &^= 1 << el
+	return nil
+}
+
+func (b Bits64) Intersection(other Bits64) Bits64 {
+	return b & other
+}
+
+// IntersectAll64 returns the elements found in every one of the sets
+func IntersectAll64(sets ...Bits64) Bits64 {
+	if len(sets) == 0 {
+		return 0
+	}
+	result := sets[0]
+	for _, other := range sets[1:] {
+		result &= other
+	}
+	return result
+}
+
+func (b Bits64) Union(other Bits64) Bits64 {
+	return b | other
+}
+
+// Difference returns the elements of b which aren't in other
+func (b Bits64) Difference(other Bits64) Bits64 {
+	return b &^ other
+}
+
+// SymmetricDifference returns the elements in exactly one of the sets
+func (b Bits64) SymmetricDifference(other Bits64) Bits64 {
+	return b ^ other
+}
+
+// IsSubset reports whether every element of b is in other
+func (b Bits64) IsSubset(other Bits64) bool {
+	return b&^other == 0
+}
+
+// IsSuperset reports whether every element of other is in b
+func (b Bits64) IsSuperset(other Bits64) bool {
+	return other.IsSubset(b)
+}
+
+func (b Bits64) Equal(other Bits64) bool {
+	return b == other
+}
+
+func (b Bits64) Clone() Bits64 {
+	return b
+}
+
+// Filter returns the elements for which fn returns true
+func (b Bits64) Filter(fn func(int) bool) Bits64 {
+	var result Bits64
+	b.Each(func(el int) {
+		if fn(el) {
+			result.Add(el)
+		}
+	})
+	return result
+}
+
+// Map returns a new set of the results of calling fn on each element, which
+// must be from 0 to 63
+func (b Bits64) Map(fn func(int) int) Bits64 {
+	var result Bits64
+	b.Each(func(el int) {
+		result.Add(fn(el))
+	})
+	return result
+}
+
+// PopAny removes and returns the smallest element
+func (b *Bits64) PopAny() (int, error) {
+	if b.Empty() {
+		return 0, errors.New("Set didn't have any elements to pop")
+	}
+	el := bits.TrailingZeros64(uint64(*b))
+	*b &^= 1 << el
+	return el, nil
+}
+
+// Next returns the smallest element which is at least from, e.g.
+//
+//	for el, ok := b.Next(0); ok; el, ok = b.Next(el + 1) {
+//		...
+//	}
+func (b Bits64) Next(from int) (int, bool) {
+	if from < 0 {
+		from = 0
+	}
+	if from >= 64 {
+		return 0, false
+	}
+	rest := uint64(b) >> from
+	if rest == 0 {
+		return 0, false
+	}
+	return from + bits.TrailingZeros64(rest), true
+}
+
+// Each calls fn with every element in ascending order
+func (b Bits64) Each(fn func(int)) {
+	for rest := uint64(b); rest != 0; rest &= rest - 1 {
+		fn(bits.TrailingZeros64(rest))
+	}
+}
+
+// ToSlice returns the elements in ascending order
+func (b Bits64) ToSlice() []int {
+	result := make([]int, 0, b.Len())
+	b.Each(func(el int) {
+		result = append(result, el)
+	})
+	return result
+}
+
+// SortedSlice is the same as ToSlice, since the elements are always in order
+func (b Bits64) SortedSlice() []int {
+	return b.ToSlice()
+}
+
+// Hash mixes the bits so that similar sets hash differently, for use in hash
+// tables of their own
+func (b Bits64) Hash() uint64 {
+	return hash.Mix(uint64(b))
+}
@@ -0,0 +1,249 @@
+// Package bitset has sets of small non-negative integers stored as bits,
+// which avoid the map allocations of set.Set for small dense domains
+package bitset
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/martin-nyaga/aoc-2022/util/hash"
+)
+
+// Bitset is a set of non-negative integers of any size, growing as needed.
+// Bitsets aren't comparable, use Key to use one as a map key.
+type Bitset struct {
+	words []uint64
+}
+
+func New(elements ...int) Bitset {
+	var b Bitset
+	for _, el := range elements {
+		b.Add(el)
+	}
+	return b
+}
+
+// NewWidth makes an empty set with room for the integers 0 to width-1
+// without growing
+func NewWidth(width int) Bitset {
+	return Bitset{words: make([]uint64, (width+63)/64)}
+}
+
+func (b *Bitset) Add(el int) {
+	if el < 0 {
+		panic(fmt.Errorf("%d can't go in a Bitset", el))
+	}
+	word := el / 64
+	for word >= len(b.words) {
+		b.words = append(b.words, 0)
+	}
+	b.words[word] |= 1 << (el % 64)
+}
+
+func (b *Bitset) Has(el int) bool {
+	word := el / 64
+	return el >= 0 && word < len(b.words) && b.words[word]&(1<<(el%64)) != 0
+}
+
+func (b *Bitset) Empty() bool {
+	for _, word := range b.words {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Len is the number of elements in the set, its popcount
+func (b *Bitset) Len() int {
+	count := 0
+	for _, word := range b.words {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+func (b *Bitset) Remove(el int) error {
+	if !b.Has(el) {
+		return fmt.Errorf("Set doesn't have %d", el)
+	}
+	b.words[el/64] &^= 1 << (el % 64)
+	return nil
+}
+
+// combine applies op to each pair of words, treating missing words as zero
+func (b *Bitset) combine(other *Bitset, op func(a, b uint64) uint64) Bitset {
+	n := len(b.words)
+	if len(other.words) > n {
+		n = len(other.words)
+	}
+	result := Bitset{words: make([]uint64, n)}
+	for i := range result.words {
+		var x, y uint64
+		if i < len(b.words) {
+			x = b.words[i]
+		}
+		if i < len(other.words) {
+			y = other.words[i]
+		}
+		result.words[i] = op(x, y)
+	}
+	return result
+}
+
+func (b *Bitset) Intersection(other *Bitset) Bitset {
+	return b.combine(other, func(x, y uint64) uint64 { return x & y })
+}
+
+// IntersectAll returns the elements found in every one of the sets
+func IntersectAll(sets ...*Bitset) Bitset {
+	if len(sets) == 0 {
+		return Bitset{}
+	}
+	result := sets[0].Clone()
+	for _, other := range sets[1:] {
+		result = result.Intersection(other)
+	}
+	return result
+}
+
+func (b *Bitset) Union(other *Bitset) Bitset {
+	return b.combine(other, func(x, y uint64) uint64 { return x | y })
+}
+
+// Difference returns the elements of b which aren't in other
+func (b *Bitset) Difference(other *Bitset) Bitset {
+	return b.combine(other, func(x, y uint64) uint64 { return x &^ y })
+}
+
+// SymmetricDifference returns the elements in exactly one of the sets
+func (b *Bitset) SymmetricDifference(other *Bitset) Bitset {
+	return b.combine(other, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// IsSubset reports whether every element of b is in other
+func (b *Bitset) IsSubset(other *Bitset) bool {
+	difference := b.Difference(other)
+	return difference.Empty()
+}
+
+// IsSuperset reports whether every element of other is in b
+func (b *Bitset) IsSuperset(other *Bitset) bool {
+	return other.IsSubset(b)
+}
+
+func (b *Bitset) Equal(other *Bitset) bool {
+	difference := b.SymmetricDifference(other)
+	return difference.Empty()
+}
+
+func (b *Bitset) Clone() Bitset {
+	return Bitset{words: append([]uint64(nil), b.words...)}
+}
+
+// Filter returns the elements for which fn returns true
+func (b *Bitset) Filter(fn func(int) bool) Bitset {
+	result := NewWidth(len(b.words) * 64)
+	b.Each(func(el int) {
+		if fn(el) {
+			result.Add(el)
+		}
+	})
+	return result
+}
+
+// Map returns a new set of the results of calling fn on each element, which
+// mustn't be negative
+func (b *Bitset) Map(fn func(int) int) Bitset {
+	var result Bitset
+	b.Each(func(el int) {
+		result.Add(fn(el))
+	})
+	return result
+}
+
+// PopAny removes and returns the smallest element
+func (b *Bitset) PopAny() (int, error) {
+	el, ok := b.Next(0)
+	if !ok {
+		return 0, errors.New("Set didn't have any elements to pop")
+	}
+	b.words[el/64] &^= 1 << (el % 64)
+	return el, nil
+}
+
+// Next returns the smallest element which is at least from, e.g.
+//
+//	for el, ok := b.Next(0); ok; el, ok = b.Next(el + 1) {
+//		...
+//	}
+func (b *Bitset) Next(from int) (int, bool) {
+	if from < 0 {
+		from = 0
+	}
+	for word := from / 64; word < len(b.words); word++ {
+		rest := b.words[word]
+		if word == from/64 {
+			rest &^= (1 << (from % 64)) - 1
+		}
+		if rest != 0 {
+			return word*64 + bits.TrailingZeros64(rest), true
+		}
+	}
+	return 0, false
+}
+
+// Each calls fn with every element in ascending order
+func (b *Bitset) Each(fn func(int)) {
+	for i, word := range b.words {
+		for rest := word; rest != 0; rest &= rest - 1 {
+			fn(i*64 + bits.TrailingZeros64(rest))
+		}
+	}
+}
+
+// ToSlice returns the elements in ascending order
+func (b *Bitset) ToSlice() []int {
+	result := make([]int, 0, b.Len())
+	b.Each(func(el int) {
+		result = append(result, el)
+	})
+	return result
+}
+
+// SortedSlice is the same as ToSlice, since the elements are always in order
+func (b *Bitset) SortedSlice() []int {
+	return b.ToSlice()
+}
+
+// trimmed leaves out trailing empty words, so equal sets have the same words
+func (b *Bitset) trimmed() []uint64 {
+	n := len(b.words)
+	for n > 0 && b.words[n-1] == 0 {
+		n -= 1
+	}
+	return b.words[:n]
+}
+
+// Hash is the same for equal sets, whatever their width
+func (b *Bitset) Hash() uint64 {
+	var h uint64
+	for _, word := range b.trimmed() {
+		h = hash.Mix(h ^ hash.Mix(word))
+	}
+	return h
+}
+
+// Key packs the set into a string which is the same for equal sets, for use
+// as a map key
+func (b *Bitset) Key() string {
+	var sb strings.Builder
+	for _, word := range b.trimmed() {
+		for i := 0; i < 8; i++ {
+			sb.WriteByte(byte(word >> (8 * i)))
+		}
+	}
+	return sb.String()
+}
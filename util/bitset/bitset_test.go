@@ -0,0 +1,161 @@
+package bitset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBits64(t *testing.T) {
+	b := New64()
+	assert.True(t, b.Empty())
+	b.Add(0)
+	b.Add(63)
+	b.Add(63)
+	assert.Equal(t, 2, b.Len())
+	assert.True(t, b.Has(63))
+	assert.False(t, b.Has(1))
+	assert.False(t, b.Has(64))
+	assert.False(t, b.Has(-1))
+	assert.Panics(t, func() { b.Add(64) })
+
+	assert.Nil(t, b.Remove(0))
+	assert.NotNil(t, b.Remove(0))
+	assert.Equal(t, []int{63}, b.ToSlice())
+}
+
+func TestBits64Algebra(t *testing.T) {
+	a := New64(1, 2, 3)
+	b := New64(3, 4)
+	assert.Equal(t, New64(3), a.Intersection(b))
+	assert.Equal(t, New64(1, 2, 3, 4), a.Union(b))
+	assert.Equal(t, New64(1, 2), a.Difference(b))
+	assert.Equal(t, New64(1, 2, 4), a.SymmetricDifference(b))
+	assert.True(t, New64(1, 3).IsSubset(a))
+	assert.False(t, b.IsSubset(a))
+	assert.True(t, a.IsSuperset(New64(2)))
+	assert.True(t, a.Equal(New64(3, 2, 1)))
+	assert.Equal(t, New64(2), a.Filter(func(el int) bool { return el%2 == 0 }))
+	assert.Equal(t, New64(2, 4, 6), a.Map(func(el int) int { return el * 2 }))
+	assert.Equal(t, New64(3), IntersectAll64(a, b, New64(3, 5)))
+	assert.Equal(t, New64(), IntersectAll64())
+	assert.Equal(t, []int{1, 2, 3}, a.SortedSlice())
+
+	// Sets are values, so cloning and copying are the same thing
+	c := a.Clone()
+	c.Add(10)
+	assert.False(t, a.Has(10))
+}
+
+func TestBits64Iteration(t *testing.T) {
+	b := New64(5, 0, 40)
+	found := []int{}
+	for el, ok := b.Next(0); ok; el, ok = b.Next(el + 1) {
+		found = append(found, el)
+	}
+	assert.Equal(t, []int{0, 5, 40}, found)
+	_, ok := b.Next(41)
+	assert.False(t, ok)
+	_, ok = b.Next(64)
+	assert.False(t, ok)
+
+	el, err := b.PopAny()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, el)
+	assert.Equal(t, 2, b.Len())
+	empty := New64()
+	_, err = empty.PopAny()
+	assert.NotNil(t, err)
+}
+
+func TestBits64MapKey(t *testing.T) {
+	seen := map[Bits64]int{}
+	seen[New64(1, 2)] = 1
+	seen[New64(2, 1)] += 1
+	assert.Equal(t, 1, len(seen))
+	assert.Equal(t, New64(1, 2).Hash(), New64(2, 1).Hash())
+	assert.NotEqual(t, New64(1).Hash(), New64(2).Hash())
+}
+
+func TestBitset(t *testing.T) {
+	b := New()
+	assert.True(t, b.Empty())
+	b.Add(3)
+	b.Add(200)
+	assert.Equal(t, 2, b.Len())
+	assert.True(t, b.Has(200))
+	assert.False(t, b.Has(1000))
+	assert.False(t, b.Has(-1))
+	assert.Panics(t, func() { b.Add(-1) })
+
+	assert.Nil(t, b.Remove(200))
+	assert.NotNil(t, b.Remove(200))
+	assert.Equal(t, []int{3}, b.ToSlice())
+}
+
+func TestBitsetAlgebra(t *testing.T) {
+	a := New(1, 100, 130)
+	b := New(100, 2)
+
+	intersection := a.Intersection(&b)
+	union := a.Union(&b)
+	difference := a.Difference(&b)
+	symmetric := a.SymmetricDifference(&b)
+	assert.Equal(t, []int{100}, intersection.ToSlice())
+	assert.Equal(t, []int{1, 2, 100, 130}, union.ToSlice())
+	assert.Equal(t, []int{1, 130}, difference.ToSlice())
+	assert.Equal(t, []int{1, 2, 130}, symmetric.ToSlice())
+
+	sub := New(1, 130)
+	assert.True(t, sub.IsSubset(&a))
+	assert.True(t, a.IsSuperset(&sub))
+	assert.False(t, b.IsSubset(&a))
+
+	even := a.Filter(func(el int) bool { return el%2 == 0 })
+	assert.Equal(t, []int{100, 130}, even.ToSlice())
+
+	halved := a.Map(func(el int) int { return el / 2 })
+	assert.Equal(t, []int{0, 50, 65}, halved.SortedSlice())
+	c := New(100, 130)
+	all := IntersectAll(&a, &b, &c)
+	assert.Equal(t, []int{100}, all.ToSlice())
+	none := IntersectAll()
+	assert.True(t, none.Empty())
+
+	clone := a.Clone()
+	clone.Add(7)
+	assert.False(t, a.Has(7))
+}
+
+func TestBitsetWidthDoesntMatter(t *testing.T) {
+	narrow := New(1, 2)
+	wide := NewWidth(1000)
+	wide.Add(2)
+	wide.Add(1)
+	assert.True(t, narrow.Equal(&wide))
+	assert.Equal(t, narrow.Hash(), wide.Hash())
+	assert.Equal(t, narrow.Key(), wide.Key())
+
+	other := New(1, 3)
+	assert.NotEqual(t, narrow.Key(), other.Key())
+	assert.NotEqual(t, narrow.Hash(), other.Hash())
+}
+
+func TestBitsetIteration(t *testing.T) {
+	b := New(64, 5, 300)
+	found := []int{}
+	for el, ok := b.Next(0); ok; el, ok = b.Next(el + 1) {
+		found = append(found, el)
+	}
+	assert.Equal(t, []int{5, 64, 300}, found)
+
+	el, err := b.PopAny()
+	assert.Nil(t, err)
+	assert.Equal(t, 5, el)
+	el, err = b.PopAny()
+	assert.Nil(t, err)
+	assert.Equal(t, 64, el)
+	empty := New()
+	_, err = empty.PopAny()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,92 @@
+// Package counter has a multiset, counting how many times each element was
+// added
+package counter
+
+import (
+	"fmt"
+	"sort"
+)
+
+type Counter[T comparable] map[T]int
+
+func New[T comparable](elements ...T) Counter[T] {
+	c := Counter[T]{}
+	for _, el := range elements {
+		c.Inc(el)
+	}
+	return c
+}
+
+func (c *Counter[T]) Inc(el T) {
+	c.Add(el, 1)
+}
+
+// Add adds n to the count of el. Adding zero does nothing, and n can't be
+// negative.
+func (c *Counter[T]) Add(el T, n int) {
+	if n < 0 {
+		panic(fmt.Errorf("Can't add %d to a Counter", n))
+	}
+	if n == 0 {
+		return
+	}
+	(*c)[el] += n
+}
+
+// Dec takes one off the count of el, forgetting it once it gets to zero
+func (c *Counter[T]) Dec(el T) error {
+	count, exists := (*c)[el]
+	if !exists {
+		return fmt.Errorf("Counter doesn't have %#v", el)
+	}
+	if count == 1 {
+		delete(*c, el)
+	} else {
+		(*c)[el] = count - 1
+	}
+	return nil
+}
+
+func (c *Counter[T]) Count(el T) int {
+	return (*c)[el]
+}
+
+// Distinct is the number of different elements
+func (c *Counter[T]) Distinct() int {
+	return len(*c)
+}
+
+// Total is the sum of all the counts
+func (c *Counter[T]) Total() int {
+	total := 0
+	for _, count := range *c {
+		total += count
+	}
+	return total
+}
+
+type Entry[T comparable] struct {
+	Value T
+	Count int
+}
+
+// MostCommon returns the n elements with the highest counts, highest first.
+// Elements with the same count come out in no particular order.
+func (c *Counter[T]) MostCommon(n int) []Entry[T] {
+	entries := make([]Entry[T], 0, len(*c))
+	for el, count := range *c {
+		entries = append(entries, Entry[T]{el, count})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Count > entries[j].Count })
+	if n < len(entries) {
+		entries = entries[:n]
+	}
+	return entries
+}
+
+// Merge adds the counts of other to c
+func (c *Counter[T]) Merge(other *Counter[T]) {
+	for el, count := range *other {
+		c.Add(el, count)
+	}
+}
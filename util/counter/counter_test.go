@@ -0,0 +1,48 @@
+package counter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounter(t *testing.T) {
+	c := New('a', 'b', 'a')
+	assert.Equal(t, 2, c.Count('a'))
+	assert.Equal(t, 0, c.Count('z'))
+	assert.Equal(t, 2, c.Distinct())
+	assert.Equal(t, 3, c.Total())
+
+	c.Add('z', 5)
+	assert.Equal(t, 5, c.Count('z'))
+	c.Add('y', 0)
+	assert.Equal(t, 3, c.Distinct())
+	assert.Panics(t, func() { c.Add('z', -1) })
+}
+
+func TestDec(t *testing.T) {
+	c := New("x", "x")
+	assert.Nil(t, c.Dec("x"))
+	assert.Equal(t, 1, c.Count("x"))
+	assert.Nil(t, c.Dec("x"))
+	assert.Equal(t, 0, c.Distinct())
+	assert.NotNil(t, c.Dec("x"))
+}
+
+func TestMostCommon(t *testing.T) {
+	c := New(1, 2, 2, 3, 3, 3)
+	assert.Equal(t, []Entry[int]{{3, 3}, {2, 2}}, c.MostCommon(2))
+	assert.Equal(t, 3, len(c.MostCommon(10)))
+	empty := New[int]()
+	assert.Empty(t, empty.MostCommon(2))
+}
+
+func TestMerge(t *testing.T) {
+	a := New(1, 2)
+	b := New(2, 3)
+	a.Merge(&b)
+	assert.Equal(t, 1, a.Count(1))
+	assert.Equal(t, 2, a.Count(2))
+	assert.Equal(t, 1, a.Count(3))
+	assert.Equal(t, 1, b.Count(2))
+}
@@ -0,0 +1,18 @@
+package util
+
+import (
+	"flag"
+	"fmt"
+	"math"
+)
+
+var Debug = flag.Bool("debug", false, "Print debug output")
+var Draw = flag.Bool("draw", false, "Draw simulations each frame")
+var Step = flag.Bool("step", false, "Advance simulations by pressing any key, esc exits")
+var MaxIter = flag.Int("maxiter", math.MaxInt, "Maximum number of search iterations")
+
+func Debugln(args ...interface{}) {
+	if *Debug {
+		fmt.Println(args...)
+	}
+}
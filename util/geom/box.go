@@ -0,0 +1,53 @@
+package geom
+
+// Box is the rectangle between two corners, including its edges
+type Box struct {
+	Min, Max Point
+}
+
+// BoundingBox is the smallest box holding all the points, and false if
+// there aren't any
+func BoundingBox(points ...Point) (Box, bool) {
+	if len(points) == 0 {
+		return Box{}, false
+	}
+	box := Box{points[0], points[0]}
+	for _, p := range points[1:] {
+		box = box.Extend(p)
+	}
+	return box, true
+}
+
+// Extend grows the box to hold p
+func (b Box) Extend(p Point) Box {
+	for i := range p {
+		if p[i] < b.Min[i] {
+			b.Min[i] = p[i]
+		}
+		if p[i] > b.Max[i] {
+			b.Max[i] = p[i]
+		}
+	}
+	return b
+}
+
+func (b Box) Contains(p Point) bool {
+	return b.Min[0] <= p[0] && p[0] <= b.Max[0] && b.Min[1] <= p[1] && p[1] <= b.Max[1]
+}
+
+func (b Box) Width() int {
+	return b.Max[0] - b.Min[0] + 1
+}
+
+func (b Box) Height() int {
+	return b.Max[1] - b.Min[1] + 1
+}
+
+// Each calls fn with every point in the box, row by row
+func (b Box) Each(fn func(Point)) {
+	for y := b.Min[1]; y <= b.Max[1]; y++ {
+		for x := b.Min[0]; x <= b.Max[0]; x++ {
+			fn(Point{x, y})
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package geom
+
+import "fmt"
+
+// Direction is one of the four ways along the axes, named by the letters
+// puzzle inputs use for them
+type Direction byte
+
+const (
+	Up    Direction = 'U'
+	Right Direction = 'R'
+	Down  Direction = 'D'
+	Left  Direction = 'L'
+)
+
+// Directions lists the directions clockwise from Up
+var Directions = [4]Direction{Up, Right, Down, Left}
+
+func ParseDirection(s string) (Direction, error) {
+	if len(s) == 1 {
+		for _, d := range Directions {
+			if s[0] == byte(d) {
+				return d, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("Unknown direction %q", s)
+}
+
+func (d Direction) index() int {
+	for i, other := range Directions {
+		if d == other {
+			return i
+		}
+	}
+	panic(fmt.Errorf("Unknown direction %q", byte(d)))
+}
+
+// Vector is a single step in the direction
+func (d Direction) Vector() Vector {
+	switch d {
+	case Up:
+		return Vector{0, -1}
+	case Right:
+		return Vector{1, 0}
+	case Down:
+		return Vector{0, 1}
+	case Left:
+		return Vector{-1, 0}
+	}
+	panic(fmt.Errorf("Unknown direction %q", byte(d)))
+}
+
+func (d Direction) TurnRight() Direction {
+	return Directions[(d.index()+1)%4]
+}
+
+func (d Direction) TurnLeft() Direction {
+	return Directions[(d.index()+3)%4]
+}
+
+func (d Direction) Opposite() Direction {
+	return Directions[(d.index()+2)%4]
+}
+
+func (d Direction) String() string {
+	return string(byte(d))
+}
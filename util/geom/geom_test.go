@@ -0,0 +1,93 @@
+package geom
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArithmetic(t *testing.T) {
+	p := Point{1, 2}
+	q := Point{4, -2}
+	assert.Equal(t, Vector{3, -4}, q.Sub(p))
+	assert.Equal(t, q, p.Add(q.Sub(p)))
+	assert.Equal(t, Vector{6, -8}, q.Sub(p).Scale(2))
+	assert.Equal(t, Vector{-3, 4}, q.Sub(p).Neg())
+	assert.Equal(t, Vector{1, -1}, q.Sub(p).Sign())
+	assert.Equal(t, Vector{0, 0}, Vector{}.Sign())
+}
+
+func TestDistances(t *testing.T) {
+	p := Point{1, 2}
+	q := Point{4, -2}
+	assert.Equal(t, 7, Manhattan(p, q))
+	assert.Equal(t, 4, Chebyshev(p, q))
+	assert.Equal(t, 0, Manhattan(p, p))
+}
+
+func TestRotate(t *testing.T) {
+	assert.Equal(t, Right.Vector(), Up.Vector().Rotate(1))
+	assert.Equal(t, Left.Vector(), Up.Vector().Rotate(-1))
+	assert.Equal(t, Vector{-2, 1}, Vector{2, -1}.Rotate(2))
+	assert.Equal(t, Vector{2, -1}, Vector{2, -1}.Rotate(4))
+	assert.Equal(t, Point{6, 5}, Point{5, 4}.RotateAround(Point{5, 5}, 1))
+}
+
+func TestNeighbours(t *testing.T) {
+	p := Point{0, 0}
+	assert.Equal(t, [4]Point{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}, p.Neighbours4())
+	for _, n := range p.Neighbours8() {
+		assert.Equal(t, 1, Chebyshev(p, n))
+	}
+}
+
+func TestDirections(t *testing.T) {
+	d, err := ParseDirection("L")
+	assert.Nil(t, err)
+	assert.Equal(t, Left, d)
+	_, err = ParseDirection("X")
+	assert.NotNil(t, err)
+	_, err = ParseDirection("")
+	assert.NotNil(t, err)
+
+	assert.Equal(t, Vector{0, -1}, Up.Vector())
+	assert.Equal(t, Vector{0, 1}, Down.Vector())
+	assert.Equal(t, Right, Up.TurnRight())
+	assert.Equal(t, Left, Up.TurnLeft())
+	assert.Equal(t, Down, Up.Opposite())
+	assert.Equal(t, "R", Right.String())
+}
+
+func TestBoundingBox(t *testing.T) {
+	_, ok := BoundingBox()
+	assert.False(t, ok)
+
+	box, ok := BoundingBox(Point{3, 1}, Point{-1, 4}, Point{0, 0})
+	assert.True(t, ok)
+	assert.Equal(t, Box{Point{-1, 0}, Point{3, 4}}, box)
+	assert.Equal(t, 5, box.Width())
+	assert.Equal(t, 5, box.Height())
+	assert.True(t, box.Contains(Point{3, 4}))
+	assert.False(t, box.Contains(Point{4, 4}))
+
+	count := 0
+	box.Each(func(Point) { count++ })
+	assert.Equal(t, 25, count)
+}
+
+func TestSegment(t *testing.T) {
+	assert.Equal(t, []Point{{2, 1}, {1, 1}, {0, 1}}, Segment{Point{2, 1}, Point{0, 1}}.Points())
+	assert.Equal(t, []Point{{0, 0}, {1, 1}, {2, 2}}, Segment{Point{0, 0}, Point{2, 2}}.Points())
+	assert.Equal(t, []Point{{5, 5}}, Segment{Point{5, 5}, Point{5, 5}}.Points())
+	assert.Equal(t, 4, Segment{Point{0, 3}, Point{0, 0}}.Len())
+	assert.Panics(t, func() { Segment{Point{0, 0}, Point{1, 2}}.Len() })
+
+	diagonal := Segment{Point{0, 4}, Point{4, 0}}
+	assert.True(t, diagonal.Contains(Point{1, 3}))
+	assert.False(t, diagonal.Contains(Point{1, 1}))
+
+	floor := Segment{Point{math.MinInt, 10}, Point{math.MaxInt, 10}}
+	assert.True(t, floor.Contains(Point{500, 10}))
+	assert.False(t, floor.Contains(Point{500, 9}))
+}
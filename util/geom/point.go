@@ -0,0 +1,116 @@
+// Package geom has integer 2D points and vectors. Y grows downwards, as it
+// does in puzzle inputs and on screen, so Up is {0, -1}.
+package geom
+
+type Point [2]int
+
+// Vector is the difference between two points
+type Vector [2]int
+
+func (p Point) X() int { return p[0] }
+func (p Point) Y() int { return p[1] }
+
+func (p Point) Add(v Vector) Point {
+	return Point{p[0] + v[0], p[1] + v[1]}
+}
+
+// Sub returns the vector from q to p
+func (p Point) Sub(q Point) Vector {
+	return Vector{p[0] - q[0], p[1] - q[1]}
+}
+
+func (v Vector) Add(w Vector) Vector {
+	return Vector{v[0] + w[0], v[1] + w[1]}
+}
+
+func (v Vector) Scale(k int) Vector {
+	return Vector{v[0] * k, v[1] * k}
+}
+
+func (v Vector) Neg() Vector {
+	return Vector{-v[0], -v[1]}
+}
+
+// Sign shrinks each component to -1, 0 or 1, giving the single step in
+// the vector's rough direction
+func (v Vector) Sign() Vector {
+	return Vector{Sign(v[0]), Sign(v[1])}
+}
+
+// Rotate turns the vector clockwise by the given number of quarter turns,
+// anticlockwise if negative
+func (v Vector) Rotate(quarterTurns int) Vector {
+	for turns := ((quarterTurns % 4) + 4) % 4; turns > 0; turns-- {
+		v = Vector{-v[1], v[0]}
+	}
+	return v
+}
+
+// Manhattan is the length of the vector moving along the axes
+func (v Vector) Manhattan() int {
+	return Abs(v[0]) + Abs(v[1])
+}
+
+// Chebyshev is the length of the vector moving diagonally as well
+func (v Vector) Chebyshev() int {
+	x, y := Abs(v[0]), Abs(v[1])
+	if x > y {
+		return x
+	}
+	return y
+}
+
+func Manhattan(p, q Point) int {
+	return p.Sub(q).Manhattan()
+}
+
+func Chebyshev(p, q Point) int {
+	return p.Sub(q).Chebyshev()
+}
+
+// RotateAround turns p clockwise around center by the given number of
+// quarter turns
+func (p Point) RotateAround(center Point, quarterTurns int) Point {
+	return center.Add(p.Sub(center).Rotate(quarterTurns))
+}
+
+// Neighbours4 are the offsets to the points sharing an edge, clockwise
+// from Up
+var Neighbours4 = [4]Vector{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+
+// Neighbours8 are the offsets to the points sharing an edge or a corner,
+// clockwise from Up
+var Neighbours8 = [8]Vector{{0, -1}, {1, -1}, {1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}}
+
+func (p Point) Neighbours4() [4]Point {
+	var result [4]Point
+	for i, v := range Neighbours4 {
+		result[i] = p.Add(v)
+	}
+	return result
+}
+
+func (p Point) Neighbours8() [8]Point {
+	var result [8]Point
+	for i, v := range Neighbours8 {
+		result[i] = p.Add(v)
+	}
+	return result
+}
+
+func Abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func Sign(x int) int {
+	switch {
+	case x < 0:
+		return -1
+	case x > 0:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,80 @@
+package geom
+
+import "fmt"
+
+// Segment is the straight line between two points, including both ends. Only
+// horizontal, vertical and diagonal segments are supported, which are the
+// ones made of whole points.
+type Segment struct {
+	A, B Point
+}
+
+func (s Segment) Horizontal() bool {
+	return s.A[1] == s.B[1]
+}
+
+func (s Segment) Vertical() bool {
+	return s.A[0] == s.B[0]
+}
+
+func (s Segment) Diagonal() bool {
+	d := s.B.Sub(s.A)
+	return Abs(d[0]) == Abs(d[1])
+}
+
+func (s Segment) check() {
+	if !s.Horizontal() && !s.Vertical() && !s.Diagonal() {
+		panic(fmt.Errorf("Segment %v isn't horizontal, vertical or diagonal", s))
+	}
+}
+
+// Len is the number of points on the segment
+func (s Segment) Len() int {
+	s.check()
+	return s.B.Sub(s.A).Chebyshev() + 1
+}
+
+// Each calls fn with every point from A to B
+func (s Segment) Each(fn func(Point)) {
+	s.check()
+	step := s.B.Sub(s.A).Sign()
+	for p := s.A; ; p = p.Add(step) {
+		fn(p)
+		if p == s.B {
+			return
+		}
+	}
+}
+
+func (s Segment) Points() []Point {
+	result := make([]Point, 0, s.Len())
+	s.Each(func(p Point) {
+		result = append(result, p)
+	})
+	return result
+}
+
+// Contains reports whether p is on the segment. It avoids subtracting, so
+// horizontal and vertical segments can run out to math.MinInt or math.MaxInt.
+func (s Segment) Contains(p Point) bool {
+	switch {
+	case s.Horizontal():
+		return p[1] == s.A[1] && between(p[0], s.A[0], s.B[0])
+	case s.Vertical():
+		return p[0] == s.A[0] && between(p[1], s.A[1], s.B[1])
+	}
+	s.check()
+	if !between(p[0], s.A[0], s.B[0]) || !between(p[1], s.A[1], s.B[1]) {
+		return false
+	}
+	d := s.B.Sub(s.A).Sign()
+	q := p.Sub(s.A)
+	return q[0]*d[0] == q[1]*d[1]
+}
+
+func between(x, a, b int) bool {
+	if a > b {
+		a, b = b, a
+	}
+	return a <= x && x <= b
+}
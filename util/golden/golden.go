@@ -0,0 +1,148 @@
+// Package golden checks solvers against the answers recorded in
+// days/testdata/answers.json
+package golden
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/stretchr/testify/assert"
+)
+
+// AnswersPath is relative to the repository root
+var AnswersPath = filepath.Join("days", "testdata", "answers.json")
+
+var update = flag.Bool("update", false, "Record the current answers in "+AnswersPath)
+var slow = flag.Bool("slow", false, "Also solve inputs which take a long time")
+
+// slowInputs take too long to solve on every test run, so are only checked
+// with -slow
+var slowInputs = map[string]bool{
+	"14/input.txt": true,
+	"15/input.txt": true,
+	"16/input.txt": true,
+}
+
+// Answers are keyed by day, then input file, then part
+type Answers map[string]map[string]map[string]string
+
+func (a Answers) Get(day, input string, part int) (string, bool) {
+	answer, exists := a[day][input][strconv.Itoa(part)]
+	return answer, exists
+}
+
+func (a Answers) Set(day, input string, part int, answer string) {
+	if _, exists := a[day]; !exists {
+		a[day] = map[string]map[string]string{}
+	}
+	if _, exists := a[day][input]; !exists {
+		a[day][input] = map[string]string{}
+	}
+	a[day][input][strconv.Itoa(part)] = answer
+}
+
+func readAnswers(t *testing.T, path string) Answers {
+	answers := Answers{}
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) && *update {
+		return answers
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(bytes, &answers); err != nil {
+		t.Fatal(err)
+	}
+	return answers
+}
+
+func writeAnswers(t *testing.T, path string, answers Answers) {
+	bytes, err := json.MarshalIndent(answers, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, append(bytes, '\n'), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func inputsFor(t *testing.T, root string, day int) []string {
+	inputs, err := filepath.Glob(filepath.Join(root, util.DayDir(day), "*.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(inputs)
+	return inputs
+}
+
+func solveInput(registration util.Registration, path string) []util.Result {
+	file, err := os.Open(path)
+	if err != nil {
+		return []util.Result{{Day: registration.Day, Variant: registration.Variant, Part: 1, Err: err}}
+	}
+	defer file.Close()
+
+	// Some days pick their parameters based on whether the sample is in use
+	old := *util.UseSampleInput
+	defer func() { *util.UseSampleInput = old }()
+	*util.UseSampleInput = strings.HasPrefix(filepath.Base(path), "sample")
+
+	return util.Solve(registration, file, 1, 2)
+}
+
+// Check solves every input of the given solvers and compares the answers to
+// the golden ones, or records them when run with -update. root is the path to
+// the repository root from the test's directory.
+func Check(t *testing.T, root string, registrations []util.Registration) {
+	answersPath := filepath.Join(root, AnswersPath)
+	answers := readAnswers(t, answersPath)
+
+	for _, registration := range registrations {
+		registration := registration
+		day := util.DayDir(registration.Day)
+		for _, path := range inputsFor(t, root, registration.Day) {
+			path := path
+			input := filepath.Base(path)
+			t.Run(registration.Name()+"/"+input, func(t *testing.T) {
+				if slowInputs[day+"/"+input] && !*slow {
+					// Skipping is only safe if there's an answer to check when
+					// it is run
+					if len(answers[day][input]) == 0 && !*update {
+						t.Fatal("No golden answers for slow input, run with -slow -update to record them")
+					}
+					t.Skip("slow input, run with -slow")
+				}
+
+				for _, result := range solveInput(registration, path) {
+					if !assert.Nil(t, result.Err, "part %d", result.Part) {
+						continue
+					}
+
+					answer := result.Answer.String()
+					if *update {
+						answers.Set(day, input, result.Part, answer)
+						continue
+					}
+
+					expected, exists := answers.Get(day, input, result.Part)
+					if !exists {
+						t.Errorf("No golden answer for part %d, run with -update to record %q", result.Part, answer)
+						continue
+					}
+					assert.Equal(t, expected, answer, "part %d", result.Part)
+				}
+			})
+		}
+	}
+
+	if *update {
+		writeAnswers(t, answersPath, answers)
+	}
+}
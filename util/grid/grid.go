@@ -0,0 +1,186 @@
+// Package grid has a fixed size rectangular grid of cells, addressed by
+// geom.Point with {0, 0} in the top left
+package grid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/geom"
+	"github.com/martin-nyaga/aoc-2022/util/parse"
+)
+
+type Grid[T any] struct {
+	width  int
+	height int
+	cells  []T
+}
+
+// New makes a grid with every cell set to the zero value
+func New[T any](width, height int) Grid[T] {
+	return Grid[T]{width: width, height: height, cells: make([]T, width*height)}
+}
+
+// Parse reads a grid of characters, one row per line, converting each with
+// cell. Conversion errors are reported at the character's line and column.
+func Parse[T any](lines []string, cell func(p geom.Point, b byte) (T, error)) (Grid[T], error) {
+	rows, err := parse.Grid(lines)
+	if err != nil {
+		return Grid[T]{}, err
+	}
+	width := 0
+	if len(rows) > 0 {
+		width = len(rows[0])
+	}
+	g := New[T](width, len(rows))
+	for y, row := range rows {
+		for x, b := range row {
+			p := geom.Point{x, y}
+			val, err := cell(p, b)
+			if err != nil {
+				return Grid[T]{}, &util.ParseError{Line: y + 1, Column: x + 1, Text: lines[y], Err: err}
+			}
+			g.Set(p, val)
+		}
+	}
+	return g, nil
+}
+
+// ParseBytes reads a grid of characters as they are
+func ParseBytes(lines []string) (Grid[byte], error) {
+	return Parse(lines, func(_ geom.Point, b byte) (byte, error) { return b, nil })
+}
+
+func (g *Grid[T]) Width() int {
+	return g.width
+}
+
+func (g *Grid[T]) Height() int {
+	return g.height
+}
+
+func (g *Grid[T]) Bounds() geom.Box {
+	return geom.Box{Min: geom.Point{0, 0}, Max: geom.Point{g.width - 1, g.height - 1}}
+}
+
+func (g *Grid[T]) InBounds(p geom.Point) bool {
+	return p[0] >= 0 && p[0] < g.width && p[1] >= 0 && p[1] < g.height
+}
+
+func (g *Grid[T]) index(p geom.Point) int {
+	if !g.InBounds(p) {
+		panic(fmt.Errorf("%v is outside the %dx%d grid", p, g.width, g.height))
+	}
+	return p[1]*g.width + p[0]
+}
+
+// At returns the cell at p, which must be in bounds
+func (g *Grid[T]) At(p geom.Point) T {
+	return g.cells[g.index(p)]
+}
+
+// Get returns the cell at p, and false if it's out of bounds
+func (g *Grid[T]) Get(p geom.Point) (T, bool) {
+	if !g.InBounds(p) {
+		var zero T
+		return zero, false
+	}
+	return g.cells[g.index(p)], true
+}
+
+func (g *Grid[T]) Set(p geom.Point, val T) {
+	g.cells[g.index(p)] = val
+}
+
+// Each calls fn with every cell, row by row
+func (g *Grid[T]) Each(fn func(p geom.Point, val T)) {
+	for i, val := range g.cells {
+		fn(geom.Point{i % g.width, i / g.width}, val)
+	}
+}
+
+// Ray calls fn with each cell from the one after start in steps of v, until
+// it leaves the grid or fn returns false
+func (g *Grid[T]) Ray(start geom.Point, v geom.Vector, fn func(p geom.Point, val T) bool) {
+	for p := start.Add(v); g.InBounds(p); p = p.Add(v) {
+		if !fn(p, g.At(p)) {
+			return
+		}
+	}
+}
+
+// Neighbours4 are the points sharing an edge with p which are in the grid
+func (g *Grid[T]) Neighbours4(p geom.Point) []geom.Point {
+	neighbours := p.Neighbours4()
+	return g.inBounds(neighbours[:])
+}
+
+// Neighbours8 are the points sharing an edge or a corner with p which are in
+// the grid
+func (g *Grid[T]) Neighbours8(p geom.Point) []geom.Point {
+	neighbours := p.Neighbours8()
+	return g.inBounds(neighbours[:])
+}
+
+func (g *Grid[T]) inBounds(points []geom.Point) []geom.Point {
+	result := points[:0]
+	for _, p := range points {
+		if g.InBounds(p) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// Transpose flips the grid over its top left to bottom right diagonal
+func (g *Grid[T]) Transpose() Grid[T] {
+	result := New[T](g.height, g.width)
+	g.Each(func(p geom.Point, val T) {
+		result.Set(geom.Point{p[1], p[0]}, val)
+	})
+	return result
+}
+
+// RotateRight turns the grid a quarter turn clockwise
+func (g *Grid[T]) RotateRight() Grid[T] {
+	result := New[T](g.height, g.width)
+	g.Each(func(p geom.Point, val T) {
+		result.Set(geom.Point{g.height - 1 - p[1], p[0]}, val)
+	})
+	return result
+}
+
+// RotateLeft turns the grid a quarter turn anticlockwise
+func (g *Grid[T]) RotateLeft() Grid[T] {
+	result := New[T](g.height, g.width)
+	g.Each(func(p geom.Point, val T) {
+		result.Set(geom.Point{p[1], g.width - 1 - p[0]}, val)
+	})
+	return result
+}
+
+// Render draws the grid a row per line, using cell to draw each cell
+func (g *Grid[T]) Render(cell func(T) string) string {
+	var sb strings.Builder
+	for i, val := range g.cells {
+		sb.WriteString(cell(val))
+		if i%g.width == g.width-1 {
+			sb.WriteByte('\n')
+		}
+	}
+	return sb.String()
+}
+
+// String draws bytes and runes as characters, and anything else with %v
+func (g *Grid[T]) String() string {
+	return g.Render(func(val T) string {
+		switch v := any(val).(type) {
+		case byte:
+			return string([]byte{v})
+		case rune:
+			return string(v)
+		}
+		return fmt.Sprint(val)
+	})
+}
@@ -0,0 +1,99 @@
+package grid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/martin-nyaga/aoc-2022/util/geom"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	g, err := Parse([]string{"12", "34", "56"}, func(_ geom.Point, b byte) (int, error) {
+		return int(b - '0'), nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, g.Width())
+	assert.Equal(t, 3, g.Height())
+	assert.Equal(t, 4, g.At(geom.Point{1, 1}))
+	assert.Equal(t, "12\n34\n56\n", g.String())
+
+	_, err = ParseBytes([]string{"ab", "c"})
+	assert.NotNil(t, err)
+
+	_, err = Parse([]string{"ab", "cX"}, func(_ geom.Point, b byte) (byte, error) {
+		if b == 'X' {
+			return 0, errors.New("No X please")
+		}
+		return b, nil
+	})
+	var parseErr *util.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 2, parseErr.Line)
+	assert.Equal(t, 2, parseErr.Column)
+}
+
+func TestAccess(t *testing.T) {
+	g := New[byte](3, 2)
+	assert.True(t, g.InBounds(geom.Point{2, 1}))
+	assert.False(t, g.InBounds(geom.Point{3, 1}))
+	assert.False(t, g.InBounds(geom.Point{0, -1}))
+
+	g.Set(geom.Point{2, 1}, 'x')
+	assert.Equal(t, byte('x'), g.At(geom.Point{2, 1}))
+	_, ok := g.Get(geom.Point{5, 5})
+	assert.False(t, ok)
+	assert.Panics(t, func() { g.At(geom.Point{3, 0}) })
+	assert.Equal(t, geom.Box{Min: geom.Point{0, 0}, Max: geom.Point{2, 1}}, g.Bounds())
+}
+
+func TestRay(t *testing.T) {
+	g, _ := ParseBytes([]string{"abc", "def", "ghi"})
+	seen := ""
+	g.Ray(geom.Point{0, 0}, geom.Vector{1, 1}, func(_ geom.Point, b byte) bool {
+		seen += string(b)
+		return true
+	})
+	assert.Equal(t, "ei", seen)
+
+	seen = ""
+	g.Ray(geom.Point{2, 2}, geom.Up.Vector(), func(_ geom.Point, b byte) bool {
+		seen += string(b)
+		return b != 'f'
+	})
+	assert.Equal(t, "f", seen)
+}
+
+func TestNeighbours(t *testing.T) {
+	g := New[int](3, 3)
+	assert.Equal(t, []geom.Point{{1, 0}, {0, 1}}, g.Neighbours4(geom.Point{0, 0}))
+	assert.Equal(t, 4, len(g.Neighbours4(geom.Point{1, 1})))
+	assert.Equal(t, 3, len(g.Neighbours8(geom.Point{2, 2})))
+	assert.Equal(t, 8, len(g.Neighbours8(geom.Point{1, 1})))
+}
+
+func TestTransforms(t *testing.T) {
+	g, _ := ParseBytes([]string{"abc", "def"})
+	transposed := g.Transpose()
+	right := g.RotateRight()
+	left := g.RotateLeft()
+	assert.Equal(t, "ad\nbe\ncf\n", transposed.String())
+	assert.Equal(t, "da\neb\nfc\n", right.String())
+	assert.Equal(t, "cf\nbe\nad\n", left.String())
+	back := right.RotateLeft()
+	assert.Equal(t, g, back)
+}
+
+func TestRender(t *testing.T) {
+	g := New[bool](2, 2)
+	g.Set(geom.Point{1, 0}, true)
+	assert.Equal(t, ".#\n..\n", g.Render(func(b bool) string {
+		if b {
+			return "#"
+		}
+		return "."
+	}))
+	ints := New[int](2, 1)
+	assert.Equal(t, "00\n", ints.String())
+}
@@ -0,0 +1,202 @@
+package grid
+
+import (
+	"math/bits"
+	"strings"
+
+	"github.com/martin-nyaga/aoc-2022/util/geom"
+)
+
+const (
+	chunkBits = 4
+	chunkSize = 1 << chunkBits
+	chunkMask = chunkSize - 1
+	chunkLen  = chunkSize * chunkSize
+)
+
+// chunk holds a square of cells, with a bit set in used for each one which
+// has been set
+type chunk[T any] struct {
+	cells [chunkLen]T
+	used  [chunkLen / 64]uint64
+}
+
+func (c *chunk[T]) has(i int) bool {
+	return c.used[i/64]&(1<<(i%64)) != 0
+}
+
+func (c *chunk[T]) empty() bool {
+	for _, word := range c.used {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SparseGrid is an unbounded grid which only stores the cells that were set,
+// in chunks so that neighbouring cells are stored together. Cells which were
+// never set read as the default value.
+type SparseGrid[T any] struct {
+	chunks map[geom.Point]*chunk[T]
+	def    T
+	len    int
+	bounds geom.Box
+	// stale is set when a cell on the edge of bounds is deleted, so bounds
+	// needs recomputing before it's used
+	stale bool
+}
+
+func NewSparse[T any](def T) *SparseGrid[T] {
+	return &SparseGrid[T]{chunks: map[geom.Point]*chunk[T]{}, def: def}
+}
+
+// locate splits p into the position of its chunk and its index in the chunk.
+// Shifting rounds down, so negative points land in the right chunk.
+func locate(p geom.Point) (geom.Point, int) {
+	key := geom.Point{p[0] >> chunkBits, p[1] >> chunkBits}
+	return key, (p[1]&chunkMask)*chunkSize + p[0]&chunkMask
+}
+
+func (g *SparseGrid[T]) Set(p geom.Point, val T) {
+	key, i := locate(p)
+	c, exists := g.chunks[key]
+	if !exists {
+		c = &chunk[T]{}
+		g.chunks[key] = c
+	}
+	if !c.has(i) {
+		c.used[i/64] |= 1 << (i % 64)
+		if g.len == 0 {
+			g.bounds = geom.Box{Min: p, Max: p}
+		} else {
+			g.bounds = g.bounds.Extend(p)
+		}
+		g.len += 1
+	}
+	c.cells[i] = val
+}
+
+// Get returns the cell at p, and false if it was never set
+func (g *SparseGrid[T]) Get(p geom.Point) (T, bool) {
+	key, i := locate(p)
+	if c, exists := g.chunks[key]; exists && c.has(i) {
+		return c.cells[i], true
+	}
+	return g.def, false
+}
+
+// At returns the cell at p, or the default if it was never set
+func (g *SparseGrid[T]) At(p geom.Point) T {
+	val, _ := g.Get(p)
+	return val
+}
+
+func (g *SparseGrid[T]) Has(p geom.Point) bool {
+	_, exists := g.Get(p)
+	return exists
+}
+
+// Delete unsets the cell at p, so it reads as the default again
+func (g *SparseGrid[T]) Delete(p geom.Point) {
+	key, i := locate(p)
+	c, exists := g.chunks[key]
+	if !exists || !c.has(i) {
+		return
+	}
+	c.used[i/64] &^= 1 << (i % 64)
+	c.cells[i] = g.def
+	if c.empty() {
+		delete(g.chunks, key)
+	}
+	g.len -= 1
+	if p[0] == g.bounds.Min[0] || p[0] == g.bounds.Max[0] || p[1] == g.bounds.Min[1] || p[1] == g.bounds.Max[1] {
+		g.stale = true
+	}
+}
+
+// Len is the number of cells which are set
+func (g *SparseGrid[T]) Len() int {
+	return g.len
+}
+
+// Bounds is the smallest box holding every cell which is set, and false if
+// there aren't any
+func (g *SparseGrid[T]) Bounds() (geom.Box, bool) {
+	if g.len == 0 {
+		return geom.Box{}, false
+	}
+	if g.stale {
+		first := true
+		g.Each(func(p geom.Point, _ T) {
+			if first {
+				g.bounds = geom.Box{Min: p, Max: p}
+				first = false
+			}
+			g.bounds = g.bounds.Extend(p)
+		})
+		g.stale = false
+	}
+	return g.bounds, true
+}
+
+// Each calls fn with every cell which is set, in no particular order
+func (g *SparseGrid[T]) Each(fn func(p geom.Point, val T)) {
+	for key, c := range g.chunks {
+		c.each(key, fn)
+	}
+}
+
+func (c *chunk[T]) each(key geom.Point, fn func(p geom.Point, val T)) {
+	for w, word := range c.used {
+		for rest := word; rest != 0; rest &= rest - 1 {
+			i := w*64 + bits.TrailingZeros64(rest)
+			p := geom.Point{key[0]<<chunkBits + i%chunkSize, key[1]<<chunkBits + i/chunkSize}
+			fn(p, c.cells[i])
+		}
+	}
+}
+
+// Region calls fn with every cell in the box which is set, in no particular
+// order. Only the chunks overlapping the box are looked at.
+func (g *SparseGrid[T]) Region(box geom.Box, fn func(p geom.Point, val T)) {
+	minKey, _ := locate(box.Min)
+	maxKey, _ := locate(box.Max)
+	// Width and height are checked on their own first, since multiplying them
+	// overflows for huge boxes
+	width, height := maxKey[0]-minKey[0]+1, maxKey[1]-minKey[1]+1
+	if width > len(g.chunks) || height > len(g.chunks) || width*height > len(g.chunks) {
+		// Cheaper to check every chunk than every position in the box
+		g.Each(func(p geom.Point, val T) {
+			if box.Contains(p) {
+				fn(p, val)
+			}
+		})
+		return
+	}
+	for y := minKey[1]; y <= maxKey[1]; y++ {
+		for x := minKey[0]; x <= maxKey[0]; x++ {
+			key := geom.Point{x, y}
+			if c, exists := g.chunks[key]; exists {
+				c.each(key, func(p geom.Point, val T) {
+					if box.Contains(p) {
+						fn(p, val)
+					}
+				})
+			}
+		}
+	}
+}
+
+// Render draws the cells in view a row per line, using cell to draw each
+// one. Cells which were never set are drawn from the default.
+func (g *SparseGrid[T]) Render(view geom.Box, cell func(T) string) string {
+	var sb strings.Builder
+	for y := view.Min[1]; y <= view.Max[1]; y++ {
+		for x := view.Min[0]; x <= view.Max[0]; x++ {
+			sb.WriteString(cell(g.At(geom.Point{x, y})))
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
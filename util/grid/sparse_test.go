@@ -0,0 +1,84 @@
+package grid
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/martin-nyaga/aoc-2022/util/geom"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparseGrid(t *testing.T) {
+	g := NewSparse[byte]('.')
+	_, ok := g.Bounds()
+	assert.False(t, ok)
+
+	g.Set(geom.Point{-20, 3}, '#')
+	g.Set(geom.Point{5, -1}, 'o')
+	g.Set(geom.Point{5, -1}, 'x')
+	assert.Equal(t, 2, g.Len())
+	assert.Equal(t, byte('x'), g.At(geom.Point{5, -1}))
+	assert.Equal(t, byte('.'), g.At(geom.Point{0, 0}))
+	assert.True(t, g.Has(geom.Point{-20, 3}))
+	assert.False(t, g.Has(geom.Point{-20, 4}))
+
+	bounds, ok := g.Bounds()
+	assert.True(t, ok)
+	assert.Equal(t, geom.Box{Min: geom.Point{-20, -1}, Max: geom.Point{5, 3}}, bounds)
+}
+
+func TestSparseGridDelete(t *testing.T) {
+	g := NewSparse(0)
+	g.Set(geom.Point{0, 0}, 1)
+	g.Set(geom.Point{100, 100}, 2)
+	g.Set(geom.Point{1, 1}, 3)
+
+	g.Delete(geom.Point{100, 100})
+	g.Delete(geom.Point{100, 100})
+	assert.Equal(t, 2, g.Len())
+	assert.Equal(t, 0, g.At(geom.Point{100, 100}))
+	bounds, _ := g.Bounds()
+	assert.Equal(t, geom.Box{Min: geom.Point{0, 0}, Max: geom.Point{1, 1}}, bounds)
+
+	g.Delete(geom.Point{0, 0})
+	g.Delete(geom.Point{1, 1})
+	_, ok := g.Bounds()
+	assert.False(t, ok)
+}
+
+func collect(g *SparseGrid[int], box geom.Box) []int {
+	found := []int{}
+	g.Region(box, func(_ geom.Point, val int) {
+		found = append(found, val)
+	})
+	sort.Ints(found)
+	return found
+}
+
+func TestSparseGridRegion(t *testing.T) {
+	g := NewSparse(0)
+	for i := -40; i <= 40; i++ {
+		g.Set(geom.Point{i, i}, i)
+	}
+
+	// Small boxes look up chunks, big ones scan them all
+	assert.Equal(t, []int{-2, -1, 0, 1, 2}, collect(g, geom.Box{Min: geom.Point{-2, -5}, Max: geom.Point{5, 2}}))
+	assert.Equal(t, 81, len(collect(g, geom.Box{Min: geom.Point{-1000, -1000}, Max: geom.Point{1000, 1000}})))
+	assert.Equal(t, 81, len(collect(g, geom.Box{Min: geom.Point{math.MinInt, math.MinInt}, Max: geom.Point{math.MaxInt, math.MaxInt}})))
+
+	count := 0
+	g.Each(func(p geom.Point, val int) {
+		assert.Equal(t, p[0], val)
+		count += 1
+	})
+	assert.Equal(t, 81, count)
+}
+
+func TestSparseGridRender(t *testing.T) {
+	g := NewSparse[byte]('.')
+	g.Set(geom.Point{-1, -1}, '#')
+	g.Set(geom.Point{1, 0}, 'o')
+	view := geom.Box{Min: geom.Point{-1, -1}, Max: geom.Point{1, 0}}
+	assert.Equal(t, "#..\n..o\n", g.Render(view, func(b byte) string { return string([]byte{b}) }))
+}
@@ -0,0 +1,13 @@
+// Package hash has helpers for packages which hash their own values
+package hash
+
+// Mix is the splitmix64 finalizer, which spreads out similar values such as
+// consecutive integers so they hash differently
+func Mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,19 @@
+package hash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMixSpreadsConsecutiveValues(t *testing.T) {
+	seen := make(map[uint64]bool)
+	buckets := make(map[uint64]bool)
+	for i := uint64(0); i < 1000; i++ {
+		h := Mix(i)
+		assert.False(t, seen[h], "%d collides", i)
+		seen[h] = true
+		buckets[h%32] = true
+	}
+	assert.Equal(t, 32, len(buckets))
+}
@@ -1,26 +1,70 @@
 package util
 
 import (
-	"bufio"
 	"flag"
+	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 )
 
 var UseSampleInput = flag.Bool("sample", false, "Use sample input")
+var InputPath = flag.String("input", "", "Read input from the given file instead, - reads from stdin")
+
+// InputEnvVar can be set instead of passing -input
+const InputEnvVar = "AOC_INPUT"
 
 type InputFile struct {
 	SampleFilePath string
 	InputFilePath  string
 }
 
+// NewInputFile finds the input files for a problem, given as a day number
+// optionally followed by a part directory, e.g. "1" or "12/part1". Files in
+// the part directory are preferred over those shared by the whole day.
 func NewInputFile(problem string) InputFile {
+	segments := strings.Split(filepath.ToSlash(problem), "/")
+	if day, err := strconv.Atoi(segments[0]); err == nil {
+		segments[0] = DayDir(day)
+	}
+
+	dirs := make([]string, 0, len(segments))
+	for i := len(segments); i > 0; i-- {
+		dirs = append(dirs, "./"+strings.Join(segments[:i], "/"))
+	}
+
 	return InputFile{
-		SampleFilePath: "./" + problem + "/sample.txt",
-		InputFilePath:  "./" + problem + "/input.txt",
+		SampleFilePath: findFile(dirs, "sample.txt"),
+		InputFilePath:  findFile(dirs, "input.txt"),
 	}
 }
 
+// findFile returns the path of the first directory containing name, falling
+// back to the outermost directory so errors point at the expected location
+func findFile(dirs []string, name string) string {
+	for _, dir := range dirs {
+		path := dir + "/" + name
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return dirs[len(dirs)-1] + "/" + name
+}
+
+// InputOverride is the input path given by -input or the environment, if any
+func InputOverride() string {
+	if *InputPath != "" {
+		return *InputPath
+	}
+	return os.Getenv(InputEnvVar)
+}
+
 func (i InputFile) filePath() string {
+	if override := InputOverride(); override != "" {
+		return override
+	}
 	if *UseSampleInput {
 		return i.SampleFilePath
 	} else {
@@ -28,26 +72,71 @@ func (i InputFile) filePath() string {
 	}
 }
 
-func (i InputFile) ReadLines() []string {
-	f, err := os.Open(i.filePath())
-	HandleError(err)
+// Open opens the input file, or stdin when the input path is -
+func (i InputFile) Open() (io.ReadCloser, error) {
+	path := i.filePath()
+	if path == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Couldn't open input: %w", err)
+	}
+	return f, nil
+}
+
+// ReadLinesE reads the input a line at a time, returning any error rather
+// than panicking
+func (i InputFile) ReadLinesE() ([]string, error) {
+	f, err := i.Open()
+	if err != nil {
+		return nil, err
+	}
 	defer f.Close()
 
-	s := bufio.NewScanner(f)
-	result := make([]string, 0)
-	for s.Scan() {
-		result = append(result, s.Text())
+	return ReadLines(f)
+}
+
+func (i InputFile) ReadBytesE() ([]byte, error) {
+	f, err := i.Open()
+	if err != nil {
+		return nil, err
 	}
+	defer f.Close()
+
+	return io.ReadAll(f)
+}
+
+func (i InputFile) ReadToStringE() (string, error) {
+	s, err := i.ReadBytesE()
+	return string(s), err
+}
 
-	return result
+func (i InputFile) ReadLines() []string {
+	lines, err := i.ReadLinesE()
+	HandleError(err)
+	return lines
 }
 
 func (i InputFile) ReadBytes() []byte {
-	s, err := os.ReadFile(i.filePath())
+	s, err := i.ReadBytesE()
 	HandleError(err)
 	return s
 }
 
 func (i InputFile) ReadToString() string {
-	return string(i.ReadBytes())
+	s, err := i.ReadToStringE()
+	HandleError(err)
+	return s
+}
+
+func ReadLines(r io.Reader) ([]string, error) {
+	lines := NewLines(r)
+	result := make([]string, 0)
+	for lines.Next() {
+		result = append(result, lines.Text())
+	}
+
+	return result, lines.Err()
 }
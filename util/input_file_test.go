@@ -0,0 +1,92 @@
+package util
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// inTempTree runs fn from a temporary directory containing the given files
+func inTempTree(t *testing.T, files map[string]string, fn func()) {
+	dir := t.TempDir()
+	for name, contents := range files {
+		path := filepath.Join(dir, name)
+		assert.Nil(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.Nil(t, os.WriteFile(path, []byte(contents), 0644))
+	}
+
+	wd, err := os.Getwd()
+	assert.Nil(t, err)
+	assert.Nil(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+	fn()
+}
+
+func TestNewInputFileZeroPadsDays(t *testing.T) {
+	inTempTree(t, map[string]string{"01/input.txt": "", "01/sample.txt": ""}, func() {
+		file := NewInputFile("1")
+		assert.Equal(t, "./01/sample.txt", file.SampleFilePath)
+		assert.Equal(t, "./01/input.txt", file.InputFilePath)
+	})
+}
+
+func TestNewInputFileFallsBackFromPartDir(t *testing.T) {
+	files := map[string]string{
+		"12/input.txt":        "",
+		"12/sample.txt":       "",
+		"12/part2/sample.txt": "",
+	}
+	inTempTree(t, files, func() {
+		file := NewInputFile("12/part1")
+		assert.Equal(t, "./12/sample.txt", file.SampleFilePath)
+		assert.Equal(t, "./12/input.txt", file.InputFilePath)
+
+		file = NewInputFile("12/part2")
+		assert.Equal(t, "./12/part2/sample.txt", file.SampleFilePath)
+		assert.Equal(t, "./12/input.txt", file.InputFilePath)
+	})
+}
+
+func TestOpenMissingInput(t *testing.T) {
+	inTempTree(t, map[string]string{}, func() {
+		_, err := NewInputFile("3").Open()
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "03/input.txt")
+	})
+}
+
+func TestReadInput(t *testing.T) {
+	inTempTree(t, map[string]string{"03/input.txt": "a\nb\n"}, func() {
+		file := NewInputFile("3")
+		lines, err := file.ReadLinesE()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "b"}, lines)
+		assert.Equal(t, []string{"a", "b"}, file.ReadLines())
+		assert.Equal(t, "a\nb\n", file.ReadToString())
+
+		missing := NewInputFile("4")
+		_, err = missing.ReadBytesE()
+		assert.NotNil(t, err)
+		assert.Panics(t, func() { missing.ReadBytes() })
+	})
+}
+
+func TestOpenInputOverride(t *testing.T) {
+	inTempTree(t, map[string]string{"custom.txt": "hello"}, func() {
+		*InputPath = "custom.txt"
+		defer func() { *InputPath = "" }()
+
+		f, err := NewInputFile("3").Open()
+		assert.Nil(t, err)
+		defer f.Close()
+		contents, err := io.ReadAll(f)
+		assert.Nil(t, err)
+		assert.Equal(t, "hello", string(contents))
+	})
+
+	t.Setenv(InputEnvVar, "from-env.txt")
+	assert.Equal(t, "from-env.txt", InputOverride())
+}
@@ -0,0 +1,111 @@
+package util
+
+import (
+	"bufio"
+	"io"
+)
+
+// DefaultMaxLineSize is the longest line the line iterators accept unless
+// told otherwise
+const DefaultMaxLineSize = bufio.MaxScanTokenSize
+
+// Lines iterates over the lines of an input without reading all of it into
+// memory, e.g.
+//
+//	lines := NewLines(r)
+//	for lines.Next() {
+//		fmt.Println(lines.LineNo(), lines.Text())
+//	}
+//	err := lines.Err()
+type Lines struct {
+	scanner *bufio.Scanner
+	closer  io.Closer
+	lineNo  int
+}
+
+func NewLines(r io.Reader) *Lines {
+	return NewLinesBuffer(r, DefaultMaxLineSize)
+}
+
+// NewLinesBuffer is like NewLines but accepts lines up to maxLineSize bytes
+// long, for inputs with very long lines
+func NewLinesBuffer(r io.Reader, maxLineSize int) *Lines {
+	s := bufio.NewScanner(r)
+	initial := 4096
+	if maxLineSize < initial {
+		initial = maxLineSize
+	}
+	s.Buffer(make([]byte, 0, initial), maxLineSize)
+	return &Lines{scanner: s}
+}
+
+// Next advances to the next line, returning false at the end of the input or
+// on an error
+func (l *Lines) Next() bool {
+	if !l.scanner.Scan() {
+		return false
+	}
+	l.lineNo += 1
+	return true
+}
+
+func (l *Lines) Text() string {
+	return l.scanner.Text()
+}
+
+// LineNo is the 1-based number of the current line
+func (l *Lines) LineNo() int {
+	return l.lineNo
+}
+
+func (l *Lines) Err() error {
+	return l.scanner.Err()
+}
+
+// Close closes the underlying input when it was opened by InputFile.Lines
+func (l *Lines) Close() error {
+	if l.closer == nil {
+		return nil
+	}
+	return l.closer.Close()
+}
+
+// Scan calls fn for each line of the input in turn, stopping at the first
+// error. Lines are read as they're needed, so the input is never held in
+// memory all at once.
+func Scan(r io.Reader, fn func(lineNo int, line string) error) error {
+	return ScanBuffer(r, DefaultMaxLineSize, fn)
+}
+
+// ScanBuffer is like Scan but accepts lines up to maxLineSize bytes long
+func ScanBuffer(r io.Reader, maxLineSize int, fn func(lineNo int, line string) error) error {
+	lines := NewLinesBuffer(r, maxLineSize)
+	for lines.Next() {
+		if err := fn(lines.LineNo(), lines.Text()); err != nil {
+			return err
+		}
+	}
+	return lines.Err()
+}
+
+// Lines opens the input file for iterating over line by line. The caller must
+// Close it when done.
+func (i InputFile) Lines() (*Lines, error) {
+	f, err := i.Open()
+	if err != nil {
+		return nil, err
+	}
+	lines := NewLines(f)
+	lines.closer = f
+	return lines, nil
+}
+
+func (i InputFile) Scan(fn func(lineNo int, line string) error) error {
+	f, err := i.Open()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return Scan(f, fn)
+}
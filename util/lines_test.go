@@ -0,0 +1,66 @@
+package util
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	lines := NewLines(strings.NewReader("a\nb\n\nc"))
+	texts := make([]string, 0)
+	lineNos := make([]int, 0)
+	for lines.Next() {
+		texts = append(texts, lines.Text())
+		lineNos = append(lineNos, lines.LineNo())
+	}
+	assert.Nil(t, lines.Err())
+	assert.Equal(t, []string{"a", "b", "", "c"}, texts)
+	assert.Equal(t, []int{1, 2, 3, 4}, lineNos)
+}
+
+func TestScanStopsOnError(t *testing.T) {
+	seen := 0
+	stop := errors.New("stop")
+	err := Scan(strings.NewReader("1\n2\n3\n"), func(lineNo int, line string) error {
+		seen += 1
+		if lineNo == 2 {
+			return stop
+		}
+		return nil
+	})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, 2, seen)
+}
+
+func TestScanBuffer(t *testing.T) {
+	long := strings.Repeat("x", 100)
+	err := ScanBuffer(strings.NewReader(long), 10, func(int, string) error { return nil })
+	assert.Equal(t, bufio.ErrTooLong, err)
+
+	var got string
+	err = ScanBuffer(strings.NewReader(long), 200, func(_ int, line string) error {
+		got = line
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, long, got)
+}
+
+func TestInputFileLines(t *testing.T) {
+	inTempTree(t, map[string]string{"04/input.txt": "x\ny\n"}, func() {
+		lines, err := NewInputFile("4").Lines()
+		assert.Nil(t, err)
+		defer lines.Close()
+
+		assert.True(t, lines.Next())
+		assert.Equal(t, "x", lines.Text())
+		assert.True(t, lines.Next())
+		assert.Equal(t, "y", lines.Text())
+		assert.False(t, lines.Next())
+		assert.Nil(t, lines.Err())
+	})
+}
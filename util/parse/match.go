@@ -0,0 +1,214 @@
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type placeholder int
+
+const (
+	literal placeholder = iota
+	// {int} matches an optionally negative integer, into an *int
+	intPlaceholder
+	// {word} matches a run of letters, digits and underscores, into a *string
+	wordPlaceholder
+	// {any} matches anything up to the following literal text, into a *string
+	anyPlaceholder
+	// {list} is like {any}, split on ", " into a *[]string
+	listPlaceholder
+)
+
+var placeholders = map[string]placeholder{
+	"{int}":  intPlaceholder,
+	"{word}": wordPlaceholder,
+	"{any}":  anyPlaceholder,
+	"{list}": listPlaceholder,
+}
+
+type token struct {
+	kind placeholder
+	text string
+}
+
+// Pattern matches lines against text with placeholders, e.g.
+// "move {int} from {int} to {int}"
+type Pattern struct {
+	source string
+	tokens []token
+	fields int
+}
+
+// MatchError reports where a line stopped matching a pattern. Column is
+// 1-based.
+type MatchError struct {
+	Column   int
+	Expected string
+}
+
+func (e *MatchError) Error() string {
+	return fmt.Sprintf("Expected %s at column %d", e.Expected, e.Column)
+}
+
+func Compile(pattern string) (*Pattern, error) {
+	p := &Pattern{source: pattern, tokens: make([]token, 0)}
+	rest := pattern
+	for len(rest) > 0 {
+		start := strings.IndexByte(rest, '{')
+		if start == -1 {
+			p.tokens = append(p.tokens, token{literal, rest})
+			break
+		}
+		if start > 0 {
+			p.tokens = append(p.tokens, token{literal, rest[:start]})
+		}
+
+		end := strings.IndexByte(rest[start:], '}')
+		if end == -1 {
+			return nil, fmt.Errorf("Unclosed placeholder in pattern %q", pattern)
+		}
+		name := rest[start : start+end+1]
+		kind, exists := placeholders[name]
+		if !exists {
+			return nil, fmt.Errorf("Unknown placeholder %s in pattern %q", name, pattern)
+		}
+		if len(p.tokens) > 0 && p.tokens[len(p.tokens)-1].kind != literal {
+			return nil, fmt.Errorf("Placeholders must be separated by text in pattern %q", pattern)
+		}
+		p.tokens = append(p.tokens, token{kind, name})
+		p.fields += 1
+		rest = rest[start+end+1:]
+	}
+	return p, nil
+}
+
+func MustCompile(pattern string) *Pattern {
+	p, err := Compile(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+var cache = struct {
+	sync.Mutex
+	patterns map[string]*Pattern
+}{patterns: map[string]*Pattern{}}
+
+// Match matches s against the pattern, storing each placeholder's value in
+// the corresponding dest. Patterns are compiled once and cached.
+func Match(pattern, s string, dest ...any) error {
+	cache.Lock()
+	p, exists := cache.patterns[pattern]
+	if !exists {
+		var err error
+		p, err = Compile(pattern)
+		if err != nil {
+			cache.Unlock()
+			return err
+		}
+		cache.patterns[pattern] = p
+	}
+	cache.Unlock()
+
+	return p.Match(s, dest...)
+}
+
+func (p *Pattern) Match(s string, dest ...any) error {
+	if len(dest) != p.fields {
+		return fmt.Errorf("Pattern %q has %d placeholders but got %d destinations", p.source, p.fields, len(dest))
+	}
+
+	pos := 0
+	field := 0
+	for i, tok := range p.tokens {
+		if tok.kind == literal {
+			if !strings.HasPrefix(s[pos:], tok.text) {
+				return &MatchError{Column: pos + 1, Expected: fmt.Sprintf("%q", tok.text)}
+			}
+			pos += len(tok.text)
+			continue
+		}
+
+		end := p.scan(tok.kind, s, pos, i)
+		if end == -1 {
+			return &MatchError{Column: pos + 1, Expected: tok.text}
+		}
+		if err := store(s[pos:end], tok, dest[field]); err != nil {
+			return err
+		}
+		field += 1
+		pos = end
+	}
+
+	if pos != len(s) {
+		return &MatchError{Column: pos + 1, Expected: "end of line"}
+	}
+	return nil
+}
+
+// scan returns the end of the placeholder at tokens[i] starting from pos, or
+// -1 if it doesn't match
+func (p *Pattern) scan(kind placeholder, s string, pos int, i int) int {
+	end := pos
+	switch kind {
+	case intPlaceholder:
+		if end < len(s) && (s[end] == '-' || s[end] == '+') {
+			end += 1
+		}
+		digits := end
+		for end < len(s) && isDigit(s[end]) {
+			end += 1
+		}
+		if end == digits {
+			return -1
+		}
+	case wordPlaceholder:
+		for end < len(s) && (isDigit(s[end]) || s[end] == '_' || (s[end]|0x20 >= 'a' && s[end]|0x20 <= 'z')) {
+			end += 1
+		}
+		if end == pos {
+			return -1
+		}
+	case anyPlaceholder, listPlaceholder:
+		if i == len(p.tokens)-1 {
+			return len(s)
+		}
+		next := strings.Index(s[pos:], p.tokens[i+1].text)
+		if next == -1 {
+			return -1
+		}
+		end = pos + next
+	}
+	return end
+}
+
+func store(text string, tok token, dest any) error {
+	switch tok.kind {
+	case intPlaceholder:
+		d, ok := dest.(*int)
+		if !ok {
+			return fmt.Errorf("{int} needs an *int, got %T", dest)
+		}
+		n, err := strconv.Atoi(text)
+		if err != nil {
+			return err
+		}
+		*d = n
+	case wordPlaceholder, anyPlaceholder:
+		d, ok := dest.(*string)
+		if !ok {
+			return fmt.Errorf("%s needs a *string, got %T", tok.text, dest)
+		}
+		*d = text
+	case listPlaceholder:
+		d, ok := dest.(*[]string)
+		if !ok {
+			return fmt.Errorf("{list} needs a *[]string, got %T", dest)
+		}
+		*d = strings.Split(text, ", ")
+	}
+	return nil
+}
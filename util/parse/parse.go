@@ -0,0 +1,108 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+)
+
+func isDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
+}
+
+// Ints extracts every integer in s, in order. A '-' directly before a number
+// makes it negative unless it follows another digit, so ranges like "2-4"
+// give 2 and 4.
+func Ints(s string) []int {
+	result := make([]int, 0)
+	i := 0
+	for i < len(s) {
+		start := i
+		if s[i] == '-' && i+1 < len(s) && isDigit(s[i+1]) && (i == 0 || !isDigit(s[i-1])) {
+			i += 1
+		}
+		if !isDigit(s[i]) {
+			i += 1
+			continue
+		}
+		for i < len(s) && isDigit(s[i]) {
+			i += 1
+		}
+		n, err := strconv.Atoi(s[start:i])
+		if err == nil {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// Blocks splits lines into the groups separated by blank lines
+func Blocks(lines []string) [][]string {
+	blocks := make([][]string, 0)
+	block := make([]string, 0)
+	for _, line := range lines {
+		if len(line) == 0 {
+			if len(block) > 0 {
+				blocks = append(blocks, block)
+			}
+			block = make([]string, 0)
+			continue
+		}
+		block = append(block, line)
+	}
+	if len(block) > 0 {
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+// Grid parses lines into rows of characters. Every row must be the same
+// length.
+func Grid(lines []string) ([][]byte, error) {
+	grid := make([][]byte, 0, len(lines))
+	for i, line := range lines {
+		if len(grid) > 0 && len(line) != len(grid[0]) {
+			return nil, &util.ParseError{
+				Line:   i + 1,
+				Column: len(line) + 1,
+				Text:   line,
+				Err:    fmt.Errorf("Expected a row of %d characters, got %d", len(grid[0]), len(line)),
+			}
+		}
+		grid = append(grid, []byte(line))
+	}
+	return grid, nil
+}
+
+// Points parses a list of points like "498,4 -> 498,6 -> 496,6"
+func Points(s string) ([][2]int, error) {
+	points := make([][2]int, 0)
+	column := 1
+	for _, raw := range strings.Split(s, " -> ") {
+		var point [2]int
+		if err := Match("{int},{int}", raw, &point[0], &point[1]); err != nil {
+			var matchErr *MatchError
+			if errors.As(err, &matchErr) {
+				matchErr.Column += column - 1
+			}
+			return nil, err
+		}
+		points = append(points, point)
+		column += len(raw) + len(" -> ")
+	}
+	return points, nil
+}
+
+// LineError wraps an error from parsing a line of input in a util.ParseError,
+// pointing at the column where matching failed if it's known
+func LineError(lineNo int, line string, err error) *util.ParseError {
+	parseErr := &util.ParseError{Line: lineNo, Text: line, Err: err}
+	var matchErr *MatchError
+	if errors.As(err, &matchErr) {
+		parseErr.Column = matchErr.Column
+	}
+	return parseErr
+}
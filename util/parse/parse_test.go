@@ -0,0 +1,95 @@
+package parse
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInts(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, Ints("move 1 from 2 to 3"))
+	assert.Equal(t, []int{2, -10, 3, 16}, Ints("Sensor at x=2, y=-10: closest beacon is at x=3, y=16"))
+	assert.Equal(t, []int{2, 4, 6, 8}, Ints("2-4,6-8"))
+	assert.Equal(t, []int{-5}, Ints("-5"))
+	assert.Equal(t, []int{}, Ints("no numbers - here"))
+}
+
+func TestBlocks(t *testing.T) {
+	lines := []string{"", "a", "b", "", "", "c", ""}
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, Blocks(lines))
+	assert.Equal(t, [][]string{}, Blocks([]string{}))
+}
+
+func TestGrid(t *testing.T) {
+	grid, err := Grid([]string{"ab", "cd"})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("ab"), []byte("cd")}, grid)
+
+	_, err = Grid([]string{"ab", "c"})
+	var parseErr *util.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 2, parseErr.Line)
+}
+
+func TestPoints(t *testing.T) {
+	points, err := Points("498,4 -> 498,6 -> -496,6")
+	assert.Nil(t, err)
+	assert.Equal(t, [][2]int{{498, 4}, {498, 6}, {-496, 6}}, points)
+
+	_, err = Points("498,4 -> 498;6")
+	var matchErr *MatchError
+	assert.True(t, errors.As(err, &matchErr))
+	assert.Equal(t, 13, matchErr.Column)
+}
+
+func TestMatch(t *testing.T) {
+	var x, y, bx, by int
+	err := Match("Sensor at x={int}, y={int}: closest beacon is at x={int}, y={int}",
+		"Sensor at x=2, y=-10: closest beacon is at x=3, y=16", &x, &y, &bx, &by)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2, -10, 3, 16}, []int{x, y, bx, by})
+
+	var name, lead, valve string
+	var rate int
+	var valves []string
+	err = Match("Valve {word} has flow rate={int}; {any} to {word} {list}",
+		"Valve AA has flow rate=0; tunnels lead to valves DD, II, BB", &name, &rate, &lead, &valve, &valves)
+	assert.Nil(t, err)
+	assert.Equal(t, "AA", name)
+	assert.Equal(t, 0, rate)
+	assert.Equal(t, "tunnels lead", lead)
+	assert.Equal(t, "valves", valve)
+	assert.Equal(t, []string{"DD", "II", "BB"}, valves)
+}
+
+func TestMatchErrors(t *testing.T) {
+	var n int
+	err := Match("addx {int}", "addx x", &n)
+	var matchErr *MatchError
+	assert.True(t, errors.As(err, &matchErr))
+	assert.Equal(t, 6, matchErr.Column)
+
+	err = Match("addx {int}", "addx 3 ", &n)
+	assert.True(t, errors.As(err, &matchErr))
+	assert.Equal(t, 7, matchErr.Column)
+
+	err = Match("noop", "addx 3")
+	assert.True(t, errors.As(err, &matchErr))
+	assert.Equal(t, 1, matchErr.Column)
+
+	var s string
+	assert.NotNil(t, Match("{int}", "3", &s))
+	assert.NotNil(t, Match("{int} {int}", "3 4", &n))
+	assert.NotNil(t, Match("{float}", "3", &n))
+	assert.NotNil(t, Match("{int}{int}", "34", &n, &n))
+}
+
+func TestLineError(t *testing.T) {
+	var n int
+	err := LineError(4, "addx x", Match("addx {int}", "addx x", &n))
+	assert.Equal(t, 4, err.Line)
+	assert.Equal(t, 6, err.Column)
+	assert.Equal(t, "addx x", err.Text)
+}
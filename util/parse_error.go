@@ -0,0 +1,47 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError describes a malformed line of input. Line and Column are 1-based,
+// a Column of 0 means the position within the line isn't known.
+type ParseError struct {
+	File   string
+	Line   int
+	Column int
+	Text   string
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	file := e.File
+	if file == "" {
+		file = "input"
+	}
+	if e.Column > 0 {
+		return fmt.Sprintf("%s:%d:%d: %v", file, e.Line, e.Column, e.Err)
+	}
+	return fmt.Sprintf("%s:%d: %v", file, e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Details renders the error followed by the offending line, with a marker
+// under the column if it's known
+func (e *ParseError) Details() string {
+	var sb strings.Builder
+	sb.WriteString(e.Error())
+	sb.WriteString("\n    ")
+	sb.WriteString(e.Text)
+	sb.WriteString("\n")
+	if e.Column > 0 {
+		sb.WriteString("    ")
+		sb.WriteString(strings.Repeat(" ", e.Column-1))
+		sb.WriteString("^\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,49 @@
+package util
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseError(t *testing.T) {
+	_, cause := strconv.Atoi("x")
+	err := &ParseError{File: "01/input.txt", Line: 3, Column: 5, Text: "abc x", Err: cause}
+	assert.Equal(t, `01/input.txt:3:5: strconv.Atoi: parsing "x": invalid syntax`, err.Error())
+	assert.Equal(t, err.Error()+"\n    abc x\n        ^\n", err.Details())
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+}
+
+func TestParseErrorWithoutPosition(t *testing.T) {
+	err := &ParseError{Line: 2, Text: "abc", Err: errors.New("bad line")}
+	assert.Equal(t, "input:2: bad line", err.Error())
+	assert.Equal(t, "input:2: bad line\n    abc\n", err.Details())
+}
+
+func TestSolveNamesParseErrors(t *testing.T) {
+	registration := Registration{Day: 1, New: func() Solver { return failingSolver{} }}
+	results := Solve(registration, namedReader{"01/input.txt"}, 1, 2)
+	assert.Equal(t, 2, len(results))
+
+	var parseErr *ParseError
+	assert.True(t, errors.As(results[0].Err, &parseErr))
+	assert.Equal(t, "01/input.txt", parseErr.File)
+}
+
+type namedReader struct {
+	name string
+}
+
+func (r namedReader) Read(p []byte) (int, error) { return 0, io.EOF }
+func (r namedReader) Name() string               { return r.name }
+
+type failingSolver struct{}
+
+func (failingSolver) Parse(r io.Reader) error {
+	return &ParseError{Line: 1, Err: errors.New("bad line")}
+}
+func (failingSolver) Part1() (Answer, error) { return Answer{}, nil }
+func (failingSolver) Part2() (Answer, error) { return Answer{}, nil }
@@ -0,0 +1,207 @@
+package pqueue
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// BoundedPqueue keeps only the best Cap() elements pushed to it, dropping the
+// worst when it overflows, e.g. for the frontier of a beam search. It's a
+// min-max heap, so both the best and the worst element can be removed in
+// O(log n).
+type BoundedPqueue[P any, V any] struct {
+	items    []entry[P, V]
+	less     func(a, b P) bool
+	capacity int
+	seq      uint64
+	evicted  int
+}
+
+func NewBoundedPqueue[P queuable, V any](mode Mode, capacity int) BoundedPqueue[P, V] {
+	return NewBoundedPqueueFunc[P, V](Ordering[P](mode), capacity)
+}
+
+// NewBoundedPqueueFunc is like NewPqueueFunc, for a BoundedPqueue
+func NewBoundedPqueueFunc[P any, V any](less func(a, b P) bool, capacity int) BoundedPqueue[P, V] {
+	if capacity < 1 {
+		panic(errors.New("BoundedPqueue needs a capacity of at least 1"))
+	}
+	return BoundedPqueue[P, V]{
+		items:    make([]entry[P, V], 0, capacity),
+		less:     less,
+		capacity: capacity,
+	}
+}
+
+func (q *BoundedPqueue[P, V]) Empty() bool {
+	return len(q.items) == 0
+}
+
+func (q *BoundedPqueue[P, V]) Len() int {
+	return len(q.items)
+}
+
+func (q *BoundedPqueue[P, V]) Cap() int {
+	return q.capacity
+}
+
+// Evicted is how many elements have been dropped because the queue was full
+func (q *BoundedPqueue[P, V]) Evicted() int {
+	return q.evicted
+}
+
+// Push adds an element, returning true if the queue was full and an element
+// was dropped. The dropped element is the new one if it's no better than the
+// worst already queued.
+func (q *BoundedPqueue[P, V]) Push(p P, el V) bool {
+	e := entry[P, V]{priority: p, seq: q.seq, value: el}
+	q.seq += 1
+
+	full := len(q.items) == q.capacity
+	if full {
+		q.evicted += 1
+		worst := q.worstIndex()
+		if !e.before(q.items[worst], q.less) {
+			return true
+		}
+		q.removeAt(worst)
+	}
+
+	q.items = append(q.items, e)
+	q.pushUp(len(q.items) - 1)
+	return full
+}
+
+// Pop removes the best element
+func (q *BoundedPqueue[P, V]) Pop() (V, error) {
+	if q.Empty() {
+		var result V
+		return result, ErrEmpty
+	}
+	return q.removeAt(0), nil
+}
+
+// PopWorst removes the worst element
+func (q *BoundedPqueue[P, V]) PopWorst() (V, error) {
+	if q.Empty() {
+		var result V
+		return result, ErrEmpty
+	}
+	return q.removeAt(q.worstIndex()), nil
+}
+
+// Peek returns the best element without removing it
+func (q *BoundedPqueue[P, V]) Peek() (V, error) {
+	if q.Empty() {
+		var result V
+		return result, ErrEmpty
+	}
+	return q.items[0].value, nil
+}
+
+func (q *BoundedPqueue[P, V]) removeAt(i int) V {
+	result := q.items[i].value
+	last := len(q.items) - 1
+	q.items[i] = q.items[last]
+	q.items[last] = entry[P, V]{}
+	q.items = q.items[:last]
+	if i < len(q.items) {
+		q.pushDown(i)
+	}
+	return result
+}
+
+// The rest is the min-max heap. Even levels hold elements better than all of
+// their descendants, odd levels elements worse than all of their descendants.
+
+func (q *BoundedPqueue[P, V]) better(i, j int) bool {
+	return q.items[i].before(q.items[j], q.less)
+}
+
+func (q *BoundedPqueue[P, V]) swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+}
+
+func isBestLevel(i int) bool {
+	return bits.Len(uint(i+1))%2 == 1
+}
+
+func (q *BoundedPqueue[P, V]) worstIndex() int {
+	switch len(q.items) {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+	if q.better(1, 2) {
+		return 2
+	}
+	return 1
+}
+
+func (q *BoundedPqueue[P, V]) pushUp(i int) {
+	if i == 0 {
+		return
+	}
+	parent := (i - 1) / 2
+	if isBestLevel(i) {
+		if q.better(parent, i) {
+			q.swap(i, parent)
+			q.pushUpLevel(parent, false)
+		} else {
+			q.pushUpLevel(i, true)
+		}
+	} else {
+		if q.better(i, parent) {
+			q.swap(i, parent)
+			q.pushUpLevel(parent, true)
+		} else {
+			q.pushUpLevel(i, false)
+		}
+	}
+}
+
+// pushUpLevel moves i up through its grandparents, which are on the same kind
+// of level
+func (q *BoundedPqueue[P, V]) pushUpLevel(i int, best bool) {
+	for i > 2 {
+		grandparent := ((i-1)/2 - 1) / 2
+		if q.better(i, grandparent) != best {
+			return
+		}
+		q.swap(i, grandparent)
+		i = grandparent
+	}
+}
+
+func (q *BoundedPqueue[P, V]) pushDown(i int) {
+	best := isBestLevel(i)
+	for {
+		// Find the best (or worst) of the children and grandchildren
+		m := -1
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			for _, candidate := range []int{child, 2*child + 1, 2*child + 2} {
+				if candidate >= len(q.items) {
+					continue
+				}
+				if m == -1 || q.better(candidate, m) == best {
+					m = candidate
+				}
+			}
+		}
+		if m == -1 || q.better(m, i) != best {
+			return
+		}
+
+		q.swap(m, i)
+		if m <= 2*i+2 {
+			// m was a child, so there's nothing further down to fix
+			return
+		}
+		parent := (m - 1) / 2
+		if q.better(parent, m) == best {
+			q.swap(m, parent)
+		}
+		i = m
+	}
+}
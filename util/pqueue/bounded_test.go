@@ -0,0 +1,93 @@
+package pqueue
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func drainBounded(t *testing.T, q *BoundedPqueue[int, int]) []int {
+	popped := make([]int, 0)
+	for !q.Empty() {
+		val, err := q.Pop()
+		assert.Nil(t, err)
+		popped = append(popped, val)
+	}
+	return popped
+}
+
+func TestBoundedPqueueMin(t *testing.T) {
+	q := NewBoundedPqueue[int, int](MinQueue, 3)
+	assert.False(t, q.Push(5, 5))
+	assert.False(t, q.Push(1, 1))
+	assert.False(t, q.Push(4, 4))
+	assert.Equal(t, 3, q.Len())
+
+	// 2 is better than the worst, 4, so 5 is dropped
+	assert.True(t, q.Push(2, 2))
+	// 9 is worse than everything, so it's dropped itself
+	assert.True(t, q.Push(9, 9))
+	assert.Equal(t, 3, q.Len())
+	assert.Equal(t, 2, q.Evicted())
+
+	best, err := q.Peek()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, best)
+	assert.Equal(t, []int{1, 2, 4}, drainBounded(t, &q))
+
+	_, err = q.Pop()
+	assert.Equal(t, ErrEmpty, err)
+}
+
+func TestBoundedPqueueMax(t *testing.T) {
+	q := NewBoundedPqueue[int, int](MaxQueue, 3)
+	for _, p := range []int{5, 1, 4, 2, 9} {
+		q.Push(p, p)
+	}
+	assert.Equal(t, 2, q.Evicted())
+
+	worst, err := q.PopWorst()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, worst)
+	assert.Equal(t, []int{9, 5}, drainBounded(t, &q))
+}
+
+func TestBoundedPqueueKeepsEarliestOfEqualPriority(t *testing.T) {
+	q := NewBoundedPqueue[int, int](MaxQueue, 2)
+	for i := 0; i < 4; i++ {
+		q.Push(1, i)
+	}
+	assert.Equal(t, []int{0, 1}, drainBounded(t, &q))
+}
+
+func TestBoundedPqueueMatchesSorting(t *testing.T) {
+	for _, mode := range []Mode{MinQueue, MaxQueue} {
+		for _, capacity := range []int{1, 2, 7, 64} {
+			q := NewBoundedPqueue[int, int](mode, capacity)
+			priorities := benchPriorities(300)
+			for i, p := range priorities {
+				q.Push(p, p)
+				if i%7 == 0 {
+					q.PopWorst()
+				}
+			}
+
+			popped := drainBounded(t, &q)
+			assert.LessOrEqual(t, len(popped), capacity)
+			assert.True(t, sort.SliceIsSorted(popped, func(i, j int) bool {
+				return Ordering[int](mode)(popped[i], popped[j])
+			}), "mode %d capacity %d: %v", mode, capacity, popped)
+		}
+
+		// Without PopWorst the queue ends up with exactly the best elements
+		q := NewBoundedPqueue[int, int](mode, 10)
+		priorities := benchPriorities(300)
+		for _, p := range priorities {
+			q.Push(p, p)
+		}
+		sort.Slice(priorities, func(i, j int) bool { return Ordering[int](mode)(priorities[i], priorities[j]) })
+		assert.Equal(t, priorities[:10], drainBounded(t, &q))
+		assert.Equal(t, 290, q.Evicted())
+	}
+}
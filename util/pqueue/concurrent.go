@@ -0,0 +1,134 @@
+package pqueue
+
+import (
+	"errors"
+	"sync"
+)
+
+var ErrClosed = errors.New("Queue was closed")
+
+// ErrDone is returned by PopWait once the queue is empty and no popped
+// element is still being worked on, so nothing more can be pushed
+var ErrDone = errors.New("Queue is done")
+
+// ConcurrentPqueue is a Pqueue which several goroutines can share, e.g. as
+// the frontier of a parallel search:
+//
+//	for {
+//		state, err := q.PopWait()
+//		if err != nil {
+//			break // ErrDone or ErrClosed
+//		}
+//		for _, next := range state.next() {
+//			q.Push(next.priority(), next)
+//		}
+//		q.Done()
+//	}
+//
+// Every element returned by PopWait must be followed by a call to Done once
+// the worker has pushed anything it leads to.
+type ConcurrentPqueue[P any, V any] struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	queue    Pqueue[P, V]
+	inFlight int
+	closed   bool
+}
+
+func NewConcurrentPqueue[P queuable, V any](mode Mode) *ConcurrentPqueue[P, V] {
+	return NewConcurrentPqueueFunc[P, V](Ordering[P](mode))
+}
+
+// NewConcurrentPqueueFunc is like NewPqueueFunc, for a ConcurrentPqueue
+func NewConcurrentPqueueFunc[P any, V any](less func(a, b P) bool) *ConcurrentPqueue[P, V] {
+	q := &ConcurrentPqueue[P, V]{queue: NewPqueueFunc[P, V](less)}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+func (q *ConcurrentPqueue[P, V]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.Len()
+}
+
+func (q *ConcurrentPqueue[P, V]) Push(p P, el V) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return ErrClosed
+	}
+	q.queue.Push(p, el)
+	q.cond.Signal()
+	return nil
+}
+
+// Pop removes the next element without waiting, returning ErrEmpty if there
+// isn't one. Like PopWait, it must be followed by a call to Done.
+func (q *ConcurrentPqueue[P, V]) Pop() (V, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		var result V
+		return result, ErrClosed
+	}
+	return q.pop()
+}
+
+// PopWait removes the next element, waiting for one to be pushed if the queue
+// is empty. It returns ErrDone when the queue is empty and every popped
+// element is done, and ErrClosed after Close.
+func (q *ConcurrentPqueue[P, V]) PopWait() (V, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for q.queue.Empty() && q.inFlight > 0 && !q.closed {
+		q.cond.Wait()
+	}
+
+	var result V
+	if q.closed {
+		return result, ErrClosed
+	}
+	if q.queue.Empty() {
+		return result, ErrDone
+	}
+	return q.pop()
+}
+
+func (q *ConcurrentPqueue[P, V]) pop() (V, error) {
+	result, err := q.queue.Pop()
+	if err == nil {
+		q.inFlight += 1
+	}
+	return result, err
+}
+
+// Done marks an element returned by Pop or PopWait as finished
+func (q *ConcurrentPqueue[P, V]) Done() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.inFlight == 0 {
+		panic(errors.New("ConcurrentPqueue.Done called more times than Pop"))
+	}
+	q.inFlight -= 1
+	if q.inFlight == 0 && q.queue.Empty() {
+		q.cond.Broadcast()
+	}
+}
+
+// Finished reports whether the queue is empty with nothing in flight
+func (q *ConcurrentPqueue[P, V]) Finished() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queue.Empty() && q.inFlight == 0
+}
+
+// Close wakes every waiting worker with ErrClosed, e.g. once a search has
+// found what it's looking for. Anything left in the queue is dropped.
+func (q *ConcurrentPqueue[P, V]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	q.queue = NewPqueueFunc[P, V](q.queue.heap.less)
+	q.cond.Broadcast()
+}
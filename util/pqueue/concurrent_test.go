@@ -0,0 +1,131 @@
+package pqueue
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentPqueueSingleWorker(t *testing.T) {
+	q := NewConcurrentPqueue[int, string](MinQueue)
+	assert.Nil(t, q.Push(2, "b"))
+	assert.Nil(t, q.Push(1, "a"))
+	assert.Equal(t, 2, q.Len())
+
+	val, err := q.PopWait()
+	assert.Nil(t, err)
+	assert.Equal(t, "a", val)
+	q.Done()
+
+	val, err = q.Pop()
+	assert.Nil(t, err)
+	assert.Equal(t, "b", val)
+	assert.False(t, q.Finished())
+	q.Done()
+	assert.True(t, q.Finished())
+
+	_, err = q.Pop()
+	assert.Equal(t, ErrEmpty, err)
+	_, err = q.PopWait()
+	assert.Equal(t, ErrDone, err)
+	assert.Panics(t, q.Done)
+}
+
+// expandTree runs workers over a binary tree of n nodes numbered breadth
+// first, returning how many nodes each worker visited
+func expandTree(q *ConcurrentPqueue[int, int], workers int, n int) []int {
+	visited := make([]int, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for {
+				node, err := q.PopWait()
+				if err != nil {
+					return
+				}
+				visited[w] += 1
+				for _, child := range []int{2*node + 1, 2*node + 2} {
+					if child < n {
+						q.Push(child, child)
+					}
+				}
+				q.Done()
+			}
+		}(w)
+	}
+	wg.Wait()
+	return visited
+}
+
+func TestConcurrentPqueueTerminates(t *testing.T) {
+	for _, workers := range []int{1, 2, 8} {
+		q := NewConcurrentPqueue[int, int](MinQueue)
+		q.Push(0, 0)
+
+		total := 0
+		for _, count := range expandTree(q, workers, 5000) {
+			total += count
+		}
+		assert.Equal(t, 5000, total, "%d workers", workers)
+		assert.True(t, q.Finished())
+	}
+}
+
+func TestConcurrentPqueueClose(t *testing.T) {
+	q := NewConcurrentPqueue[int, int](MaxQueue)
+	q.Push(1, 1)
+	_, err := q.PopWait()
+	assert.Nil(t, err)
+
+	// With an element in flight, a second worker waits for more
+	var waitErr atomic.Value
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := q.PopWait()
+		waitErr.Store(err)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	assert.Nil(t, waitErr.Load())
+
+	q.Close()
+	wg.Wait()
+	assert.Equal(t, ErrClosed, waitErr.Load())
+	assert.Equal(t, ErrClosed, q.Push(2, 2))
+	_, err = q.Pop()
+	assert.Equal(t, ErrClosed, err)
+}
+
+func BenchmarkPqueueTree(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		q := NewPqueue[int, int](MinQueue)
+		q.Push(0, 0)
+		for !q.Empty() {
+			node, _ := q.Pop()
+			for _, child := range []int{2*node + 1, 2*node + 2} {
+				if child < 100000 {
+					q.Push(child, child)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkConcurrentPqueueTree(b *testing.B) {
+	for _, workers := range []int{1, 4, 8} {
+		b.Run(fmt.Sprintf("%d workers", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				q := NewConcurrentPqueue[int, int](MinQueue)
+				q.Push(0, 0)
+				expandTree(q, workers, 100000)
+			}
+		})
+	}
+}
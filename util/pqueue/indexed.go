@@ -0,0 +1,148 @@
+package pqueue
+
+import (
+	"container/heap"
+	"errors"
+)
+
+var ErrMissingKey = errors.New("Key is not in the queue")
+
+type indexedEntry[K comparable, P any, V any] struct {
+	entry[P, V]
+	key K
+}
+
+// indexedEntries implements heap.Interface, keeping track of where each key
+// is in the heap
+type indexedEntries[K comparable, P any, V any] struct {
+	items   []indexedEntry[K, P, V]
+	indexes map[K]int
+	less    func(a, b P) bool
+}
+
+func (e *indexedEntries[K, P, V]) Len() int { return len(e.items) }
+
+func (e *indexedEntries[K, P, V]) Less(i, j int) bool {
+	return e.items[i].before(e.items[j].entry, e.less)
+}
+
+func (e *indexedEntries[K, P, V]) Swap(i, j int) {
+	e.items[i], e.items[j] = e.items[j], e.items[i]
+	e.indexes[e.items[i].key] = i
+	e.indexes[e.items[j].key] = j
+}
+
+func (e *indexedEntries[K, P, V]) Push(x any) {
+	item := x.(indexedEntry[K, P, V])
+	e.indexes[item.key] = len(e.items)
+	e.items = append(e.items, item)
+}
+
+func (e *indexedEntries[K, P, V]) Pop() any {
+	last := len(e.items) - 1
+	item := e.items[last]
+	e.items[last] = indexedEntry[K, P, V]{}
+	e.items = e.items[:last]
+	delete(e.indexes, item.key)
+	return item
+}
+
+// IndexedPqueue is a priority queue holding at most one value per key, whose
+// priority can be changed after it's pushed. This allows decrease-key in
+// shortest path searches instead of pushing duplicates.
+type IndexedPqueue[K comparable, P any, V any] struct {
+	heap *indexedEntries[K, P, V]
+	seq  uint64
+}
+
+func NewIndexedPqueue[K comparable, P queuable, V any](mode Mode) IndexedPqueue[K, P, V] {
+	return NewIndexedPqueueFunc[K, P, V](Ordering[P](mode))
+}
+
+// NewIndexedPqueueFunc is like NewPqueueFunc, for an IndexedPqueue
+func NewIndexedPqueueFunc[K comparable, P any, V any](less func(a, b P) bool) IndexedPqueue[K, P, V] {
+	return IndexedPqueue[K, P, V]{
+		heap: &indexedEntries[K, P, V]{
+			items:   make([]indexedEntry[K, P, V], 0),
+			indexes: map[K]int{},
+			less:    less,
+		},
+	}
+}
+
+func (q *IndexedPqueue[K, P, V]) Empty() bool {
+	return q.heap.Len() == 0
+}
+
+func (q *IndexedPqueue[K, P, V]) Len() int {
+	return q.heap.Len()
+}
+
+func (q *IndexedPqueue[K, P, V]) Contains(key K) bool {
+	_, exists := q.heap.indexes[key]
+	return exists
+}
+
+// Priority returns the priority of the key, if it's in the queue
+func (q *IndexedPqueue[K, P, V]) Priority(key K) (P, bool) {
+	i, exists := q.heap.indexes[key]
+	if !exists {
+		var p P
+		return p, false
+	}
+	return q.heap.items[i].priority, true
+}
+
+// PushOrUpdate adds the key to the queue, or replaces its priority and value
+// if it's already there
+func (q *IndexedPqueue[K, P, V]) PushOrUpdate(key K, p P, el V) {
+	if q.Contains(key) {
+		q.Update(key, p, el)
+		return
+	}
+	heap.Push(q.heap, indexedEntry[K, P, V]{entry: entry[P, V]{priority: p, seq: q.seq, value: el}, key: key})
+	q.seq += 1
+}
+
+// Update replaces the priority and value of a key in the queue. An updated key
+// goes behind others with the same priority.
+func (q *IndexedPqueue[K, P, V]) Update(key K, p P, el V) error {
+	i, exists := q.heap.indexes[key]
+	if !exists {
+		return ErrMissingKey
+	}
+	q.heap.items[i].entry = entry[P, V]{priority: p, seq: q.seq, value: el}
+	q.seq += 1
+	heap.Fix(q.heap, i)
+	return nil
+}
+
+func (q *IndexedPqueue[K, P, V]) Remove(key K) (V, error) {
+	i, exists := q.heap.indexes[key]
+	if !exists {
+		var result V
+		return result, ErrMissingKey
+	}
+	return heap.Remove(q.heap, i).(indexedEntry[K, P, V]).value, nil
+}
+
+// Peek returns the next key and value without removing them
+func (q *IndexedPqueue[K, P, V]) Peek() (K, V, error) {
+	if q.Empty() {
+		var key K
+		var result V
+		return key, result, ErrEmpty
+	}
+	item := q.heap.items[0]
+	return item.key, item.value, nil
+}
+
+func (q *IndexedPqueue[K, P, V]) Pop() (K, V, error) {
+	if q.Empty() {
+		var key K
+		var result V
+		return key, result, ErrEmpty
+	}
+	item := heap.Pop(q.heap).(indexedEntry[K, P, V])
+	return item.key, item.value, nil
+}
@@ -0,0 +1,96 @@
+package pqueue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexedPqueueMin(t *testing.T) {
+	q := NewIndexedPqueue[string, int, string](MinQueue)
+	assert.True(t, q.Empty())
+
+	q.PushOrUpdate("a", 5, "a at 5")
+	q.PushOrUpdate("b", 3, "b at 3")
+	q.PushOrUpdate("c", 4, "c at 4")
+	assert.Equal(t, 3, q.Len())
+	assert.True(t, q.Contains("a"))
+	assert.False(t, q.Contains("d"))
+
+	key, val, err := q.Peek()
+	assert.Nil(t, err)
+	assert.Equal(t, "b", key)
+	assert.Equal(t, "b at 3", val)
+	assert.Equal(t, 3, q.Len())
+
+	// Decrease a's priority past the others
+	q.PushOrUpdate("a", 1, "a at 1")
+	assert.Equal(t, 3, q.Len())
+	p, exists := q.Priority("a")
+	assert.True(t, exists)
+	assert.Equal(t, 1, p)
+
+	key, val, err = q.Pop()
+	assert.Nil(t, err)
+	assert.Equal(t, "a", key)
+	assert.Equal(t, "a at 1", val)
+	assert.False(t, q.Contains("a"))
+	_, exists = q.Priority("a")
+	assert.False(t, exists)
+
+	// Increase b's priority behind c
+	assert.Nil(t, q.Update("b", 10, "b at 10"))
+	key, _, err = q.Pop()
+	assert.Nil(t, err)
+	assert.Equal(t, "c", key)
+	key, val, err = q.Pop()
+	assert.Nil(t, err)
+	assert.Equal(t, "b", key)
+	assert.Equal(t, "b at 10", val)
+
+	_, _, err = q.Pop()
+	assert.Equal(t, ErrEmpty, err)
+	_, _, err = q.Peek()
+	assert.Equal(t, ErrEmpty, err)
+	assert.Equal(t, ErrMissingKey, q.Update("b", 1, ""))
+}
+
+func TestIndexedPqueueMax(t *testing.T) {
+	q := NewIndexedPqueue[int, int, int](MaxQueue)
+	for i := 0; i < 10; i++ {
+		q.PushOrUpdate(i, i, i*10)
+	}
+	q.PushOrUpdate(3, 100, 30)
+
+	popped := make([]int, 0)
+	for !q.Empty() {
+		key, _, err := q.Pop()
+		assert.Nil(t, err)
+		popped = append(popped, key)
+	}
+	assert.Equal(t, []int{3, 9, 8, 7, 6, 5, 4, 2, 1, 0}, popped)
+}
+
+func TestIndexedPqueueRemove(t *testing.T) {
+	q := NewIndexedPqueue[int, int, string](MinQueue)
+	for i := 0; i < 6; i++ {
+		q.PushOrUpdate(i, i%3, "")
+	}
+
+	_, err := q.Remove(1)
+	assert.Nil(t, err)
+	_, err = q.Remove(1)
+	assert.Equal(t, ErrMissingKey, err)
+	_, err = q.Remove(3)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, q.Len())
+
+	popped := make([]int, 0)
+	for !q.Empty() {
+		key, _, err := q.Pop()
+		assert.Nil(t, err)
+		popped = append(popped, key)
+	}
+	// Equal priorities come out in the order they were pushed
+	assert.Equal(t, []int{0, 4, 2, 5}, popped)
+}
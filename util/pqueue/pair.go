@@ -0,0 +1,21 @@
+package pqueue
+
+// Pair is a composite priority, compared by First and then by Second
+type Pair[A queuable, B queuable] struct {
+	First  A
+	Second B
+}
+
+// PairOrdering compares pairs by First in firstMode, breaking ties by Second
+// in secondMode. For example PairOrdering[int, int](MaxQueue, MinQueue) puts
+// the highest First first, and the lowest Second first among equal Firsts.
+func PairOrdering[A queuable, B queuable](firstMode, secondMode Mode) func(a, b Pair[A, B]) bool {
+	first := Ordering[A](firstMode)
+	second := Ordering[B](secondMode)
+	return func(a, b Pair[A, B]) bool {
+		if a.First != b.First {
+			return first(a.First, b.First)
+		}
+		return second(a.Second, b.Second)
+	}
+}
@@ -0,0 +1,58 @@
+package pqueue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPqueueFunc(t *testing.T) {
+	// Longest first
+	q := NewPqueueFunc[string, int](func(a, b string) bool { return len(a) > len(b) })
+	for i, p := range []string{"bb", "a", "ccc", "dd"} {
+		q.Push(p, i)
+	}
+
+	popped := make([]int, 0)
+	for !q.Empty() {
+		val, err := q.Pop()
+		assert.Nil(t, err)
+		popped = append(popped, val)
+	}
+	// bb and dd have the same length so stay in the order they were pushed
+	assert.Equal(t, []int{2, 0, 3, 1}, popped)
+}
+
+func TestPairOrdering(t *testing.T) {
+	// Highest pressure, then earliest minute
+	q := NewPqueueFunc[Pair[int, int], string](PairOrdering[int, int](MaxQueue, MinQueue))
+	q.Push(Pair[int, int]{10, 5}, "10 at 5")
+	q.Push(Pair[int, int]{20, 9}, "20 at 9")
+	q.Push(Pair[int, int]{10, 2}, "10 at 2")
+	q.Push(Pair[int, int]{20, 3}, "20 at 3")
+	q.Push(Pair[int, int]{10, 2}, "10 at 2 again")
+
+	popped := make([]string, 0)
+	for !q.Empty() {
+		val, err := q.Pop()
+		assert.Nil(t, err)
+		popped = append(popped, val)
+	}
+	assert.Equal(t, []string{"20 at 3", "20 at 9", "10 at 2", "10 at 2 again", "10 at 5"}, popped)
+}
+
+func TestIndexedPqueueFunc(t *testing.T) {
+	q := NewIndexedPqueueFunc[string, Pair[int, string], int](PairOrdering[int, string](MinQueue, MaxQueue))
+	q.PushOrUpdate("a", Pair[int, string]{1, "a"}, 0)
+	q.PushOrUpdate("b", Pair[int, string]{1, "b"}, 0)
+	q.PushOrUpdate("c", Pair[int, string]{0, "c"}, 0)
+	q.PushOrUpdate("c", Pair[int, string]{2, "c"}, 0)
+
+	popped := make([]string, 0)
+	for !q.Empty() {
+		key, _, err := q.Pop()
+		assert.Nil(t, err)
+		popped = append(popped, key)
+	}
+	assert.Equal(t, []string{"b", "a", "c"}, popped)
+}
@@ -1,6 +1,7 @@
 package pqueue
 
 import (
+	"container/heap"
 	"errors"
 
 	"golang.org/x/exp/constraints"
@@ -10,102 +11,106 @@ type queuable interface {
 	constraints.Ordered
 }
 
+// Mode picks whether the lowest or highest priority comes out first
+type Mode int
+
 const (
-	MinQueue = iota
+	MinQueue Mode = iota
 	MaxQueue
 )
 
-type Pqueue[P queuable, V any] struct {
-	elements   map[P][]V
-	priorities []P
-	mode       int
+// Ordering returns the comparator used for ordered priorities in the given
+// mode
+func Ordering[P queuable](mode Mode) func(a, b P) bool {
+	if mode == MaxQueue {
+		return func(a, b P) bool { return a > b }
+	}
+	return func(a, b P) bool { return a < b }
 }
 
-func NewPqueue[P queuable, V any](mode int) Pqueue[P, V] {
-	return Pqueue[P, V]{
-		elements:   map[P][]V{},
-		priorities: make([]P, 0),
-		mode:       mode,
+var ErrEmpty = errors.New("Queue was empty")
+
+type entry[P any, V any] struct {
+	priority P
+	// seq orders elements pushed with the same priority, so they come out in
+	// the order they went in
+	seq   uint64
+	value V
+}
+
+// before reports whether a comes out of the queue before b
+func (a entry[P, V]) before(b entry[P, V], less func(a, b P) bool) bool {
+	if less(a.priority, b.priority) {
+		return true
+	}
+	if less(b.priority, a.priority) {
+		return false
 	}
+	return a.seq < b.seq
 }
 
-func (q *Pqueue[P, V]) Empty() bool {
-	return len(q.elements) == 0
+// entries implements heap.Interface
+type entries[P any, V any] struct {
+	items []entry[P, V]
+	less  func(a, b P) bool
 }
 
-func (q *Pqueue[P, V]) Len() int {
-	size := 0
-	for _, arr := range q.elements {
-		size += len(arr)
-	}
-	return size
+func (e *entries[P, V]) Len() int { return len(e.items) }
+
+func (e *entries[P, V]) Less(i, j int) bool {
+	return e.items[i].before(e.items[j], e.less)
 }
 
-func (q *Pqueue[P, V]) Push(p P, el V) {
-	if q.hasPriority(p) {
-		q.elements[p] = append(q.elements[p], el)
-	} else {
-		q.addPriority(p)
-		q.elements[p] = []V{el}
-	}
+func (e *entries[P, V]) Swap(i, j int) { e.items[i], e.items[j] = e.items[j], e.items[i] }
+
+func (e *entries[P, V]) Push(x any) { e.items = append(e.items, x.(entry[P, V])) }
+
+func (e *entries[P, V]) Pop() any {
+	last := len(e.items) - 1
+	item := e.items[last]
+	e.items[last] = entry[P, V]{}
+	e.items = e.items[:last]
+	return item
 }
 
-func (q *Pqueue[P, V]) Pop() (V, error) {
-	var result V
-	if q.Empty() {
-		return result, errors.New("Queue was epmty")
-	}
-	priority := q.priorities[0]
-	result = q.popValueWithPriority(priority)
-	if _, exists := q.elements[priority]; !exists {
-		q.priorities = q.priorities[1:]
-	}
-	return result, nil
+// Pqueue is a binary heap of values ordered by priority, lowest first for a
+// MinQueue and highest first for a MaxQueue. Values with equal priorities are
+// popped in the order they were pushed.
+type Pqueue[P any, V any] struct {
+	heap *entries[P, V]
+	seq  uint64
 }
 
-func (q *Pqueue[P, V]) popValueWithPriority(p P) V {
-	result := q.elements[p][0]
-	if len(q.elements[p]) == 1 {
-		delete(q.elements, p)
-	} else {
-		q.elements[p] = q.elements[p][1:]
-	}
-	return result
+func NewPqueue[P queuable, V any](mode Mode) Pqueue[P, V] {
+	return NewPqueueFunc[P, V](Ordering[P](mode))
 }
 
-func (q *Pqueue[P, V]) hasPriority(priority P) bool {
-	for _, prio := range q.priorities {
-		if prio == priority {
-			return true
-		}
+// NewPqueueFunc makes a queue for any type of priority, where less(a, b)
+// means a comes out before b. Priorities where neither is less than the other
+// come out in the order they were pushed.
+func NewPqueueFunc[P any, V any](less func(a, b P) bool) Pqueue[P, V] {
+	return Pqueue[P, V]{
+		heap: &entries[P, V]{items: make([]entry[P, V], 0), less: less},
 	}
-	return false
 }
 
-func (q *Pqueue[P, V]) addPriority(priority P) {
-	if len(q.priorities) == 0 {
-		q.priorities = append(q.priorities, priority)
-		return
-	}
+func (q *Pqueue[P, V]) Empty() bool {
+	return q.heap.Len() == 0
+}
 
-	insertIndex := 0
-	for i, prio := range q.priorities {
-		var insertHere bool
-		if q.mode == MinQueue {
-			insertHere = prio > priority
-		} else {
-			insertHere = prio < priority
-		}
-		if insertHere {
-			insertIndex = i
-			break
-		}
-		insertIndex += 1
-	}
+func (q *Pqueue[P, V]) Len() int {
+	return q.heap.Len()
+}
+
+func (q *Pqueue[P, V]) Push(p P, el V) {
+	heap.Push(q.heap, entry[P, V]{priority: p, seq: q.seq, value: el})
+	q.seq += 1
+}
 
-	newPriorities := make([]P, 0, len(q.priorities)+1)
-	newPriorities = append(newPriorities, q.priorities[:insertIndex]...)
-	newPriorities = append(newPriorities, priority)
-	newPriorities = append(newPriorities, q.priorities[insertIndex:]...)
-	q.priorities = newPriorities
+func (q *Pqueue[P, V]) Pop() (V, error) {
+	if q.Empty() {
+		var result V
+		return result, ErrEmpty
+	}
+	return heap.Pop(q.heap).(entry[P, V]).value, nil
 }
@@ -0,0 +1,53 @@
+package pqueue
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// benchPriorities are spread over a wide range, like the search states in
+// 16/part2, so most pushes see a new priority
+func benchPriorities(n int) []int {
+	r := rand.New(rand.NewSource(1))
+	priorities := make([]int, n)
+	for i := range priorities {
+		priorities[i] = r.Intn(n)
+	}
+	return priorities
+}
+
+type benchQueue interface {
+	Push(p int, el int)
+	Pop() (int, error)
+	Len() int
+}
+
+// benchmarkPushPop fills the queue then drains it, checking Len as it goes
+func benchmarkPushPop(b *testing.B, n int, newQueue func() benchQueue) {
+	priorities := benchPriorities(n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q := newQueue()
+		for j, p := range priorities {
+			q.Push(p, j)
+		}
+		for q.Len() > 0 {
+			q.Pop()
+		}
+	}
+}
+
+func newHeapQueue() benchQueue {
+	q := NewPqueue[int, int](MaxQueue)
+	return &q
+}
+
+func newSortedQueue() benchQueue {
+	q := newSortedPqueue[int, int](MaxQueue)
+	return &q
+}
+
+func BenchmarkPqueue1k(b *testing.B)        { benchmarkPushPop(b, 1000, newHeapQueue) }
+func BenchmarkSortedPqueue1k(b *testing.B)  { benchmarkPushPop(b, 1000, newSortedQueue) }
+func BenchmarkPqueue10k(b *testing.B)       { benchmarkPushPop(b, 10000, newHeapQueue) }
+func BenchmarkSortedPqueue10k(b *testing.B) { benchmarkPushPop(b, 10000, newSortedQueue) }
@@ -81,3 +81,43 @@ func TestPqueueMax(t *testing.T) {
 	val, err = q.Pop()
 	assert.NotNil(t, err)
 }
+
+func TestPqueueFifoWithinPriority(t *testing.T) {
+	q := NewPqueue[int, string](MinQueue)
+	for _, el := range []string{"a", "b", "c", "d"} {
+		q.Push(1, el)
+		q.Push(2, el+"2")
+	}
+
+	popped := make([]string, 0)
+	for !q.Empty() {
+		val, err := q.Pop()
+		assert.Nil(t, err)
+		popped = append(popped, val)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "a2", "b2", "c2", "d2"}, popped)
+}
+
+func TestPqueueMatchesSortedPqueue(t *testing.T) {
+	for _, mode := range []Mode{MinQueue, MaxQueue} {
+		q := NewPqueue[int, int](mode)
+		sorted := newSortedPqueue[int, int](mode)
+		for i, p := range benchPriorities(500) {
+			q.Push(p%50, i)
+			sorted.Push(p%50, i)
+			if i%3 == 0 {
+				expected, _ := sorted.Pop()
+				actual, err := q.Pop()
+				assert.Nil(t, err)
+				assert.Equal(t, expected, actual)
+			}
+		}
+		for !sorted.Empty() {
+			expected, _ := sorted.Pop()
+			actual, err := q.Pop()
+			assert.Nil(t, err)
+			assert.Equal(t, expected, actual)
+		}
+		assert.Equal(t, 0, q.Len())
+	}
+}
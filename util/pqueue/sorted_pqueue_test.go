@@ -0,0 +1,102 @@
+package pqueue
+
+import (
+	"errors"
+)
+
+// sortedPqueue is the original implementation of Pqueue, which keeps a sorted
+// slice of priorities. It's only kept to benchmark against.
+type sortedPqueue[P queuable, V any] struct {
+	elements   map[P][]V
+	priorities []P
+	mode       Mode
+}
+
+func newSortedPqueue[P queuable, V any](mode Mode) sortedPqueue[P, V] {
+	return sortedPqueue[P, V]{
+		elements:   map[P][]V{},
+		priorities: make([]P, 0),
+		mode:       mode,
+	}
+}
+
+func (q *sortedPqueue[P, V]) Empty() bool {
+	return len(q.elements) == 0
+}
+
+func (q *sortedPqueue[P, V]) Len() int {
+	size := 0
+	for _, arr := range q.elements {
+		size += len(arr)
+	}
+	return size
+}
+
+func (q *sortedPqueue[P, V]) Push(p P, el V) {
+	if q.hasPriority(p) {
+		q.elements[p] = append(q.elements[p], el)
+	} else {
+		q.addPriority(p)
+		q.elements[p] = []V{el}
+	}
+}
+
+func (q *sortedPqueue[P, V]) Pop() (V, error) {
+	var result V
+	if q.Empty() {
+		return result, errors.New("Queue was epmty")
+	}
+	priority := q.priorities[0]
+	result = q.popValueWithPriority(priority)
+	if _, exists := q.elements[priority]; !exists {
+		q.priorities = q.priorities[1:]
+	}
+	return result, nil
+}
+
+func (q *sortedPqueue[P, V]) popValueWithPriority(p P) V {
+	result := q.elements[p][0]
+	if len(q.elements[p]) == 1 {
+		delete(q.elements, p)
+	} else {
+		q.elements[p] = q.elements[p][1:]
+	}
+	return result
+}
+
+func (q *sortedPqueue[P, V]) hasPriority(priority P) bool {
+	for _, prio := range q.priorities {
+		if prio == priority {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *sortedPqueue[P, V]) addPriority(priority P) {
+	if len(q.priorities) == 0 {
+		q.priorities = append(q.priorities, priority)
+		return
+	}
+
+	insertIndex := 0
+	for i, prio := range q.priorities {
+		var insertHere bool
+		if q.mode == MinQueue {
+			insertHere = prio > priority
+		} else {
+			insertHere = prio < priority
+		}
+		if insertHere {
+			insertIndex = i
+			break
+		}
+		insertIndex += 1
+	}
+
+	newPriorities := make([]P, 0, len(q.priorities)+1)
+	newPriorities = append(newPriorities, q.priorities[:insertIndex]...)
+	newPriorities = append(newPriorities, priority)
+	newPriorities = append(newPriorities, q.priorities[insertIndex:]...)
+	q.priorities = newPriorities
+}
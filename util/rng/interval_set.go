@@ -0,0 +1,62 @@
+package rng
+
+import "sort"
+
+// IntervalSet is a set of integers stored as sorted ranges, merging ranges
+// which overlap or touch as they're added
+type IntervalSet struct {
+	ranges []Range
+}
+
+func NewIntervalSet(ranges ...Range) IntervalSet {
+	var s IntervalSet
+	for _, r := range ranges {
+		s.Add(r)
+	}
+	return s
+}
+
+func (s *IntervalSet) Add(r Range) {
+	r = r.Normalize()
+	result := make([]Range, 0, len(s.ranges)+1)
+	i := 0
+	for ; i < len(s.ranges) && s.ranges[i][1]+1 < r[0]; i++ {
+		result = append(result, s.ranges[i])
+	}
+	for ; i < len(s.ranges) && s.ranges[i].touches(r); i++ {
+		r, _ = r.Union(s.ranges[i])
+	}
+	result = append(result, r)
+	s.ranges = append(result, s.ranges[i:]...)
+}
+
+func (s *IntervalSet) Remove(r Range) {
+	result := make([]Range, 0, len(s.ranges)+1)
+	for _, existing := range s.ranges {
+		result = append(result, existing.Subtract(r)...)
+	}
+	s.ranges = result
+}
+
+func (s *IntervalSet) Contains(x int) bool {
+	i := sort.Search(len(s.ranges), func(i int) bool { return s.ranges[i][1] >= x })
+	return i < len(s.ranges) && s.ranges[i].Contains(x)
+}
+
+// Len is the number of integers in the set
+func (s *IntervalSet) Len() int {
+	total := 0
+	for _, r := range s.ranges {
+		total += r.Len()
+	}
+	return total
+}
+
+func (s *IntervalSet) Empty() bool {
+	return len(s.ranges) == 0
+}
+
+// Ranges returns the ranges making up the set, in order
+func (s *IntervalSet) Ranges() []Range {
+	return append([]Range(nil), s.ranges...)
+}
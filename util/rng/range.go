@@ -1,11 +1,96 @@
+// Package rng has inclusive integer ranges and sets of them
 package rng
 
+// Range is the integers from r[0] to r[1] inclusive. The methods treat a
+// reversed range like {4, 3} as {3, 4}.
 type Range [2]int
 
+// New makes a Range, putting the ends in order
+func New(a, b int) Range {
+	return Range{a, b}.Normalize()
+}
+
+func (r Range) Normalize() Range {
+	if r[0] > r[1] {
+		return Range{r[1], r[0]}
+	}
+	return r
+}
+
+// Len is the number of integers in the range
+func (r Range) Len() int {
+	r = r.Normalize()
+	return r[1] - r[0] + 1
+}
+
+func (r Range) Contains(x int) bool {
+	r = r.Normalize()
+	return r[0] <= x && x <= r[1]
+}
+
 func (r Range) Covers(o Range) bool {
+	r, o = r.Normalize(), o.Normalize()
 	return r[0] <= o[0] && r[1] >= o[1]
 }
 
 func (r Range) Intersects(o Range) bool {
-	return o.Covers(r) || (r[0] <= o[0] && r[1] >= o[0]) || (r[0] <= o[1] && r[1] >= o[1])
+	r, o = r.Normalize(), o.Normalize()
+	return r[0] <= o[1] && o[0] <= r[1]
+}
+
+// Intersection returns the integers in both ranges, and false if there
+// aren't any
+func (r Range) Intersection(o Range) (Range, bool) {
+	if !r.Intersects(o) {
+		return Range{}, false
+	}
+	r, o = r.Normalize(), o.Normalize()
+	return Range{maxInt(r[0], o[0]), minInt(r[1], o[1])}, true
+}
+
+// touches reports whether the ranges overlap or sit next to each other
+func (r Range) touches(o Range) bool {
+	r, o = r.Normalize(), o.Normalize()
+	return r[0] <= o[1]+1 && o[0] <= r[1]+1
+}
+
+// Union returns the integers in either range, and false if that isn't a
+// single range because there's a gap between them
+func (r Range) Union(o Range) (Range, bool) {
+	if !r.touches(o) {
+		return Range{}, false
+	}
+	r, o = r.Normalize(), o.Normalize()
+	return Range{minInt(r[0], o[0]), maxInt(r[1], o[1])}, true
+}
+
+// Subtract returns what's left of r without the integers in o, which can be
+// nothing, one range, or two if o is in the middle of r
+func (r Range) Subtract(o Range) []Range {
+	r, o = r.Normalize(), o.Normalize()
+	if !r.Intersects(o) {
+		return []Range{r}
+	}
+	result := make([]Range, 0, 2)
+	if r[0] < o[0] {
+		result = append(result, Range{r[0], o[0] - 1})
+	}
+	if r[1] > o[1] {
+		result = append(result, Range{o[1] + 1, r[1]})
+	}
+	return result
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
 }
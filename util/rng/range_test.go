@@ -21,3 +21,68 @@ func TestRangeIntersects(t *testing.T) {
 	assert.True(t, r2.Intersects(r1))
 	assert.False(t, r1.Intersects(r3))
 }
+
+func TestRangeNormalizes(t *testing.T) {
+	assert.Equal(t, Range{3, 4}, New(4, 3))
+	assert.Equal(t, 2, Range{4, 3}.Len())
+	assert.True(t, Range{4, 3}.Contains(3))
+	assert.True(t, Range{5, 0}.Covers(Range{3, 2}))
+	assert.False(t, Range{0, 5}.Intersects(Range{7, 6}))
+}
+
+func TestRangeLenAndContains(t *testing.T) {
+	r := Range{-2, 2}
+	assert.Equal(t, 5, r.Len())
+	assert.True(t, r.Contains(-2))
+	assert.True(t, r.Contains(2))
+	assert.False(t, r.Contains(3))
+}
+
+func TestRangeIntersection(t *testing.T) {
+	i, ok := Range{0, 5}.Intersection(Range{3, 9})
+	assert.True(t, ok)
+	assert.Equal(t, Range{3, 5}, i)
+	_, ok = Range{0, 5}.Intersection(Range{6, 9})
+	assert.False(t, ok)
+}
+
+func TestRangeUnion(t *testing.T) {
+	u, ok := Range{0, 5}.Union(Range{3, 9})
+	assert.True(t, ok)
+	assert.Equal(t, Range{0, 9}, u)
+	u, ok = Range{0, 5}.Union(Range{6, 9})
+	assert.True(t, ok)
+	assert.Equal(t, Range{0, 9}, u)
+	_, ok = Range{0, 5}.Union(Range{7, 9})
+	assert.False(t, ok)
+}
+
+func TestRangeSubtract(t *testing.T) {
+	assert.Equal(t, []Range{{0, 2}, {6, 9}}, Range{0, 9}.Subtract(Range{3, 5}))
+	assert.Equal(t, []Range{{4, 9}}, Range{0, 9}.Subtract(Range{-1, 3}))
+	assert.Equal(t, []Range{{0, 9}}, Range{0, 9}.Subtract(Range{10, 12}))
+	assert.Empty(t, Range{3, 5}.Subtract(Range{0, 9}))
+}
+
+func TestIntervalSet(t *testing.T) {
+	s := NewIntervalSet(Range{10, 12}, Range{0, 2}, Range{5, 6})
+	assert.Equal(t, []Range{{0, 2}, {5, 6}, {10, 12}}, s.Ranges())
+	assert.Equal(t, 8, s.Len())
+
+	// Touching ranges merge as well as overlapping ones
+	s.Add(Range{3, 4})
+	assert.Equal(t, []Range{{0, 6}, {10, 12}}, s.Ranges())
+	s.Add(Range{11, -1})
+	assert.Equal(t, []Range{{-1, 12}}, s.Ranges())
+
+	assert.True(t, s.Contains(-1))
+	assert.False(t, s.Contains(13))
+
+	s.Remove(Range{2, 3})
+	assert.Equal(t, []Range{{-1, 1}, {4, 12}}, s.Ranges())
+	assert.False(t, s.Contains(2))
+	assert.Equal(t, 12, s.Len())
+
+	s.Remove(Range{-5, 20})
+	assert.True(t, s.Empty())
+}
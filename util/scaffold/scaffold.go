@@ -0,0 +1,188 @@
+// Package scaffold generates the boilerplate for a new day from the
+// templates in templates/, which can be edited or overridden per run
+package scaffold
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/martin-nyaga/aoc-2022/util"
+)
+
+//go:embed templates/*.tmpl
+var embedded embed.FS
+
+// DefaultTemplates are the templates built into the binary
+var DefaultTemplates, _ = fs.Sub(embedded, "templates")
+
+// Files maps each template to the file it generates in the day's directory
+var Files = map[string]string{
+	"solver.go.tmpl": "solver.go",
+}
+
+// DaysFile imports every day, new days are added to it
+var DaysFile = filepath.Join("days", "days.go")
+
+// Data is passed to the templates
+type Data struct {
+	Day     int
+	Dir     string
+	Package string
+	Module  string
+}
+
+// Generate creates the directory for a new day under root, with a solver and
+// an empty sample.txt, and registers it in DaysFile. Templates missing from
+// templates, which may be nil, come from DefaultTemplates. It returns the
+// files written.
+func Generate(root string, day int, templates fs.FS) ([]string, error) {
+	module, err := modulePath(root)
+	if err != nil {
+		return nil, err
+	}
+	data := Data{
+		Day:     day,
+		Dir:     util.DayDir(day),
+		Package: "day" + util.DayDir(day),
+		Module:  module,
+	}
+	dir := filepath.Join(root, data.Dir)
+
+	// Render everything before writing anything, so a broken template doesn't
+	// leave a half generated day behind
+	rendered := make(map[string][]byte)
+	for name, file := range Files {
+		path := filepath.Join(dir, file)
+		if _, err := os.Stat(path); err == nil {
+			return nil, fmt.Errorf("%s already exists", path)
+		}
+		source, err := render(templates, name, data)
+		if err != nil {
+			return nil, err
+		}
+		rendered[path] = source
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	written := make([]string, 0)
+	for path, source := range rendered {
+		if err := os.WriteFile(path, source, 0644); err != nil {
+			return written, err
+		}
+		written = append(written, path)
+	}
+
+	samplePath := filepath.Join(dir, "sample.txt")
+	if _, err := os.Stat(samplePath); errors.Is(err, os.ErrNotExist) {
+		if err := os.WriteFile(samplePath, []byte{}, 0644); err != nil {
+			return written, err
+		}
+		written = append(written, samplePath)
+	}
+
+	daysPath := filepath.Join(root, DaysFile)
+	added, err := addImport(daysPath, module+"/"+data.Dir)
+	if err != nil {
+		return written, err
+	}
+	if added {
+		written = append(written, daysPath)
+	}
+
+	sort.Strings(written)
+	return written, nil
+}
+
+func render(templates fs.FS, name string, data Data) ([]byte, error) {
+	source, err := fs.ReadFile(DefaultTemplates, name)
+	if templates != nil {
+		if custom, customErr := fs.ReadFile(templates, name); customErr == nil {
+			source, err = custom, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New(name).Parse(string(source))
+	if err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		return nil, err
+	}
+
+	if strings.HasSuffix(Files[name], ".go") {
+		formatted, err := format.Source(out.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s doesn't generate valid Go: %w", name, err)
+		}
+		return formatted, nil
+	}
+	return out.Bytes(), nil
+}
+
+func modulePath(root string) (string, error) {
+	f, err := os.Open(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := scanner.Text(); strings.HasPrefix(line, "module ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "module ")), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", errors.New("No module line in go.mod")
+}
+
+// addImport adds a blank import of pkg to the import block of the file at
+// path, keeping the imports sorted. It returns false if it's already there.
+func addImport(path string, pkg string) (bool, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	source := string(contents)
+	start := strings.Index(source, "import (\n")
+	if start == -1 {
+		return false, fmt.Errorf("No import block in %s", path)
+	}
+	start += len("import (\n")
+	end := start + strings.Index(source[start:], ")")
+
+	imports := strings.Split(strings.TrimRight(source[start:end], "\n\t "), "\n")
+	line := fmt.Sprintf("\t_ %q", pkg)
+	for _, existing := range imports {
+		if strings.TrimSpace(existing) == strings.TrimSpace(line) {
+			return false, nil
+		}
+	}
+	imports = append(imports, line)
+	sort.Strings(imports)
+
+	updated, err := format.Source([]byte(source[:start] + strings.Join(imports, "\n") + "\n" + source[end:]))
+	if err != nil {
+		return false, err
+	}
+	return true, os.WriteFile(path, updated, 0644)
+}
@@ -0,0 +1,148 @@
+package scaffold
+
+import (
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const daysSource = `package days
+
+import (
+	_ "example.com/aoc/01"
+	_ "example.com/aoc/20"
+)
+`
+
+func tempRoot(t *testing.T) string {
+	root := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/aoc\n\ngo 1.19\n"), 0644))
+	assert.Nil(t, os.MkdirAll(filepath.Join(root, "days"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(root, DaysFile), []byte(daysSource), 0644))
+	return root
+}
+
+func read(t *testing.T, path string) string {
+	contents, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	return string(contents)
+}
+
+func TestGenerate(t *testing.T) {
+	root := tempRoot(t)
+
+	written, err := Generate(root, 7, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(root, "07", "sample.txt"),
+		filepath.Join(root, "07", "solver.go"),
+		filepath.Join(root, DaysFile),
+	}, written)
+
+	solver := read(t, filepath.Join(root, "07", "solver.go"))
+	assert.Contains(t, solver, "package day07\n")
+	assert.Contains(t, solver, `"example.com/aoc/util"`)
+	assert.Contains(t, solver, `util.Register(7, "", func() util.Solver { return &Solver{} })`)
+	assert.Equal(t, "", read(t, filepath.Join(root, "07", "sample.txt")))
+	assert.Equal(t, `package days
+
+import (
+	_ "example.com/aoc/01"
+	_ "example.com/aoc/07"
+	_ "example.com/aoc/20"
+)
+`, read(t, filepath.Join(root, DaysFile)))
+
+	_, err = Generate(root, 7, nil)
+	assert.NotNil(t, err)
+}
+
+func TestGenerateKeepsFetchedFiles(t *testing.T) {
+	root := tempRoot(t)
+	assert.Nil(t, os.MkdirAll(filepath.Join(root, "07"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(root, "07", "sample.txt"), []byte("example"), 0644))
+
+	written, err := Generate(root, 7, nil)
+	assert.Nil(t, err)
+	assert.NotContains(t, written, filepath.Join(root, "07", "sample.txt"))
+	assert.Equal(t, "example", read(t, filepath.Join(root, "07", "sample.txt")))
+}
+
+func TestGenerateCustomTemplates(t *testing.T) {
+	root := tempRoot(t)
+	templates := fstest.MapFS{
+		"solver.go.tmpl": {Data: []byte("package {{.Package}}\n\n// Day {{.Day}} lives in {{.Dir}}\n")},
+	}
+
+	_, err := Generate(root, 21, templates)
+	assert.Nil(t, err)
+	assert.Equal(t, "package day21\n\n// Day 21 lives in 21\n", read(t, filepath.Join(root, "21", "solver.go")))
+}
+
+func TestGenerateBrokenTemplate(t *testing.T) {
+	root := tempRoot(t)
+	templates := fstest.MapFS{
+		"solver.go.tmpl": {Data: []byte("package {{.Package}\n")},
+	}
+
+	_, err := Generate(root, 3, templates)
+	assert.NotNil(t, err)
+	_, err = os.Stat(filepath.Join(root, "03"))
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, daysSource, read(t, filepath.Join(root, DaysFile)))
+}
+
+// copyModule copies this module's go.mod, go.sum and util packages to a temp
+// dir, so the generated code can be built against the real util
+func copyModule(t *testing.T) string {
+	src := filepath.Join("..", "..")
+	root := t.TempDir()
+	for _, name := range []string{"go.mod", "go.sum"} {
+		assert.Nil(t, os.WriteFile(filepath.Join(root, name), []byte(read(t, filepath.Join(src, name))), 0644))
+	}
+	err := filepath.WalkDir(filepath.Join(src, "util"), func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Join(root, filepath.Dir(rel)), 0755); err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(root, rel), []byte(read(t, path)), 0644)
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, os.MkdirAll(filepath.Join(root, "days"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(root, DaysFile), []byte("package days\n\nimport (\n)\n"), 0644))
+	return root
+}
+
+func TestGeneratedDayBuilds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a module")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go isn't on the PATH")
+	}
+	root := copyModule(t)
+
+	_, err = Generate(root, 7, nil)
+	assert.Nil(t, err)
+
+	for _, args := range [][]string{{"build", "./07", "./days"}, {"vet", "./07", "./days"}} {
+		cmd := exec.Command(goBin, args...)
+		cmd.Dir = root
+		cmd.Env = append(os.Environ(), "GOPROXY=off")
+		output, err := cmd.CombinedOutput()
+		assert.Nil(t, err, "go %s: %s", strings.Join(args, " "), output)
+	}
+}
@@ -0,0 +1,176 @@
+package search
+
+import "fmt"
+
+// Distances holds the shortest distance between every pair of nodes, with
+// enough to rebuild the paths. It's a Graph itself, with an edge from each
+// node to every other node it can reach, costing the distance between them.
+type Distances[N comparable] struct {
+	nodes []N
+	index map[N]int
+	dist  [][]int
+	// parent[i][j] is the node before j on the path from i, or -1 if j can't
+	// be reached from i
+	parent [][]int
+}
+
+func newDistances[N comparable](nodes []N) *Distances[N] {
+	d := &Distances[N]{
+		nodes:  append([]N(nil), nodes...),
+		index:  make(map[N]int, len(nodes)),
+		dist:   make([][]int, len(nodes)),
+		parent: make([][]int, len(nodes)),
+	}
+	for i, n := range nodes {
+		d.index[n] = i
+		d.dist[i] = make([]int, len(nodes))
+		d.parent[i] = make([]int, len(nodes))
+		for j := range nodes {
+			d.parent[i][j] = -1
+		}
+	}
+	return d
+}
+
+func (d *Distances[N]) indexOf(n N) int {
+	i, exists := d.index[n]
+	if !exists {
+		panic(fmt.Errorf("%#v isn't one of the nodes", n))
+	}
+	return i
+}
+
+func (d *Distances[N]) reachable(i, j int) bool {
+	return i == j || d.parent[i][j] >= 0
+}
+
+// FloydWarshall finds the distances between every pair of nodes using the
+// graph's costs. Edges to nodes which aren't listed are left out.
+func FloydWarshall[N comparable](g Graph[N], nodes []N) *Distances[N] {
+	d := newDistances(nodes)
+	for i, n := range d.nodes {
+		for _, next := range g.Neighbours(n) {
+			j, exists := d.index[next]
+			if !exists || i == j {
+				continue
+			}
+			cost := g.Cost(n, next)
+			if !d.reachable(i, j) || cost < d.dist[i][j] {
+				d.dist[i][j] = cost
+				d.parent[i][j] = i
+			}
+		}
+	}
+
+	for k := range d.nodes {
+		for i := range d.nodes {
+			if !d.reachable(i, k) {
+				continue
+			}
+			for j := range d.nodes {
+				if i == j || !d.reachable(k, j) {
+					continue
+				}
+				through := d.dist[i][k] + d.dist[k][j]
+				if !d.reachable(i, j) || through < d.dist[i][j] {
+					d.dist[i][j] = through
+					d.parent[i][j] = d.parent[k][j]
+				}
+			}
+		}
+	}
+	return d
+}
+
+// AllPairsBFS finds the distances between every pair of nodes by running a
+// BFS from each, counting every edge as 1. Every neighbour must be one of
+// the nodes. Paths are the same ones BFS would find.
+func AllPairsBFS[N comparable](g Graph[N], nodes []N) *Distances[N] {
+	d := newDistances(nodes)
+	for i := range d.nodes {
+		queue := []int{i}
+		for len(queue) > 0 {
+			n := queue[0]
+			queue = queue[1:]
+			for _, next := range g.Neighbours(d.nodes[n]) {
+				j := d.indexOf(next)
+				if d.reachable(i, j) {
+					continue
+				}
+				d.dist[i][j] = d.dist[i][n] + 1
+				d.parent[i][j] = n
+				queue = append(queue, j)
+			}
+		}
+	}
+	return d
+}
+
+func (d *Distances[N]) Nodes() []N {
+	return append([]N(nil), d.nodes...)
+}
+
+// Distance is the cost of the shortest path from a to b, and false if there
+// isn't one
+func (d *Distances[N]) Distance(a, b N) (int, bool) {
+	i, j := d.indexOf(a), d.indexOf(b)
+	if !d.reachable(i, j) {
+		return 0, false
+	}
+	return d.dist[i][j], true
+}
+
+// Path is the shortest path from a to b including both, and false if there
+// isn't one
+func (d *Distances[N]) Path(a, b N) ([]N, bool) {
+	i, j := d.indexOf(a), d.indexOf(b)
+	if !d.reachable(i, j) {
+		return nil, false
+	}
+	path := []N{d.nodes[j]}
+	for n := j; n != i; n = d.parent[i][n] {
+		path = append(path, d.nodes[d.parent[i][n]])
+	}
+	for l, r := 0, len(path)-1; l < r; l, r = l+1, r-1 {
+		path[l], path[r] = path[r], path[l]
+	}
+	return path, true
+}
+
+// Contract keeps just the given nodes, with a direct edge between each pair
+// costing the distance between them in the full graph. Searches over the
+// result skip the nodes in between, so paths only hold kept nodes.
+func (d *Distances[N]) Contract(keep []N) *Distances[N] {
+	result := newDistances(keep)
+	for i, a := range keep {
+		for j, b := range keep {
+			if dist, ok := d.Distance(a, b); ok && i != j {
+				result.dist[i][j] = dist
+				result.parent[i][j] = i
+			}
+		}
+	}
+	return result
+}
+
+// Neighbours are the other nodes reachable from n, in the order the nodes
+// were given
+func (d *Distances[N]) Neighbours(n N) []N {
+	i := d.indexOf(n)
+	result := make([]N, 0, len(d.nodes))
+	for j, other := range d.nodes {
+		if i != j && d.reachable(i, j) {
+			result = append(result, other)
+		}
+	}
+	return result
+}
+
+func (d *Distances[N]) Cost(from, to N) int {
+	dist, _ := d.Distance(from, to)
+	return dist
+}
+
+func (d *Distances[N]) Heuristic(n N) int {
+	return 0
+}
@@ -0,0 +1,79 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tunnels is a small weighted graph, B to C is cheaper going through D
+var tunnels = Funcs[string]{
+	NeighboursFunc: func(n string) []string {
+		return map[string][]string{
+			"A": {"B"},
+			"B": {"A", "C", "D"},
+			"C": {"B", "D"},
+			"D": {"B", "C"},
+			"E": {},
+		}[n]
+	},
+	CostFunc: func(from, to string) int {
+		if (from == "B" && to == "C") || (from == "C" && to == "B") {
+			return 5
+		}
+		return 1
+	},
+}
+
+var nodes = []string{"A", "B", "C", "D", "E"}
+
+func TestFloydWarshall(t *testing.T) {
+	d := FloydWarshall[string](tunnels, nodes)
+	dist, ok := d.Distance("A", "C")
+	assert.True(t, ok)
+	assert.Equal(t, 3, dist)
+	path, ok := d.Path("A", "C")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"A", "B", "D", "C"}, path)
+
+	dist, ok = d.Distance("C", "C")
+	assert.True(t, ok)
+	assert.Equal(t, 0, dist)
+	path, _ = d.Path("C", "C")
+	assert.Equal(t, []string{"C"}, path)
+
+	_, ok = d.Distance("A", "E")
+	assert.False(t, ok)
+	_, ok = d.Path("E", "A")
+	assert.False(t, ok)
+	assert.Panics(t, func() { d.Distance("A", "Z") })
+}
+
+func TestAllPairsBFS(t *testing.T) {
+	d := AllPairsBFS[string](tunnels, nodes)
+	dist, _ := d.Distance("A", "C")
+	assert.Equal(t, 2, dist)
+
+	// The same path as a BFS from A finds
+	bfs, err := BFS[string](tunnels, []string{"A"}, func(n string) bool { return n == "C" })
+	assert.Nil(t, err)
+	path, _ := d.Path("A", "C")
+	assert.Equal(t, bfs.Path, path)
+	_, ok := d.Distance("E", "A")
+	assert.False(t, ok)
+}
+
+func TestContract(t *testing.T) {
+	d := FloydWarshall[string](tunnels, nodes).Contract([]string{"A", "C", "E"})
+	assert.Equal(t, []string{"A", "C", "E"}, d.Nodes())
+	assert.Equal(t, []string{"C"}, d.Neighbours("A"))
+	assert.Equal(t, 3, d.Cost("A", "C"))
+	assert.Empty(t, d.Neighbours("E"))
+	path, _ := d.Path("C", "A")
+	assert.Equal(t, []string{"C", "A"}, path)
+
+	// The contracted graph can be searched like any other
+	result, err := Dijkstra[string](d, []string{"C"}, func(n string) bool { return n == "A" })
+	assert.Nil(t, err)
+	assert.Equal(t, 3, result.Distance)
+}
@@ -0,0 +1,174 @@
+// Package search finds shortest paths through graphs given as a Graph
+// implementation, so the graph never has to be built up front
+package search
+
+import (
+	"errors"
+
+	"github.com/martin-nyaga/aoc-2022/util/pqueue"
+)
+
+var ErrNoPath = errors.New("No path to a goal")
+
+type Graph[N comparable] interface {
+	Neighbours(n N) []N
+	// Cost is the cost of the edge from one node to its neighbour. BFS
+	// ignores it and counts every edge as 1.
+	Cost(from, to N) int
+	// Heuristic estimates the cost from n to the nearest goal. A* needs it to
+	// never overestimate, and Dijkstra and BFS ignore it.
+	Heuristic(n N) int
+}
+
+// Funcs makes a Graph out of functions. Cost defaults to 1 and Heuristic to 0
+// when left out.
+type Funcs[N comparable] struct {
+	NeighboursFunc func(n N) []N
+	CostFunc       func(from, to N) int
+	HeuristicFunc  func(n N) int
+}
+
+func (f Funcs[N]) Neighbours(n N) []N {
+	return f.NeighboursFunc(n)
+}
+
+func (f Funcs[N]) Cost(from, to N) int {
+	if f.CostFunc == nil {
+		return 1
+	}
+	return f.CostFunc(from, to)
+}
+
+func (f Funcs[N]) Heuristic(n N) int {
+	if f.HeuristicFunc == nil {
+		return 0
+	}
+	return f.HeuristicFunc(n)
+}
+
+// Stats counts the work a search did
+type Stats struct {
+	// Expanded is the number of nodes whose neighbours were looked at
+	Expanded int
+	// MaxFrontier is the most nodes waiting to be expanded at once
+	MaxFrontier int
+}
+
+func (s *Stats) frontier(size int) {
+	if size > s.MaxFrontier {
+		s.MaxFrontier = size
+	}
+}
+
+type Result[N comparable] struct {
+	// Goal is the goal which was reached
+	Goal     N
+	Distance int
+	// Path runs from the start the goal was reached from to the goal,
+	// including both
+	Path  []N
+	Stats Stats
+}
+
+// search holds the state shared by the algorithms
+type search[N comparable] struct {
+	dist   map[N]int
+	parent map[N]N
+	stats  Stats
+}
+
+func newSearch[N comparable]() *search[N] {
+	return &search[N]{dist: map[N]int{}, parent: map[N]N{}}
+}
+
+func (s *search[N]) result(goal N) Result[N] {
+	path := []N{goal}
+	for n, ok := s.parent[goal]; ok; n, ok = s.parent[n] {
+		path = append(path, n)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return Result[N]{Goal: goal, Distance: s.dist[goal], Path: path, Stats: s.stats}
+}
+
+// BFS finds the path with the fewest edges from any of the starts to a node
+// for which isGoal returns true, stopping as soon as it reaches one.
+// Neighbours are explored in the order the graph returns them.
+func BFS[N comparable](g Graph[N], starts []N, isGoal func(N) bool) (Result[N], error) {
+	s := newSearch[N]()
+	queue := make([]N, 0, len(starts))
+	for _, start := range starts {
+		if _, seen := s.dist[start]; !seen {
+			s.dist[start] = 0
+			queue = append(queue, start)
+		}
+	}
+
+	for len(queue) > 0 {
+		s.stats.frontier(len(queue))
+		n := queue[0]
+		queue = queue[1:]
+		if isGoal(n) {
+			return s.result(n), nil
+		}
+
+		s.stats.Expanded += 1
+		for _, next := range g.Neighbours(n) {
+			if _, seen := s.dist[next]; seen {
+				continue
+			}
+			s.dist[next] = s.dist[n] + 1
+			s.parent[next] = n
+			queue = append(queue, next)
+		}
+	}
+	return Result[N]{Stats: s.stats}, ErrNoPath
+}
+
+// Dijkstra finds the cheapest path from any of the starts to a node for which
+// isGoal returns true. Costs mustn't be negative.
+func Dijkstra[N comparable](g Graph[N], starts []N, isGoal func(N) bool) (Result[N], error) {
+	return best(g, starts, isGoal, func(N) int { return 0 })
+}
+
+// AStar is Dijkstra guided by the graph's Heuristic, so it looks at fewer
+// nodes the better the estimate is
+func AStar[N comparable](g Graph[N], starts []N, isGoal func(N) bool) (Result[N], error) {
+	return best(g, starts, isGoal, g.Heuristic)
+}
+
+func best[N comparable](g Graph[N], starts []N, isGoal func(N) bool, heuristic func(N) int) (Result[N], error) {
+	s := newSearch[N]()
+	queue := pqueue.NewIndexedPqueue[N, int, N](pqueue.MinQueue)
+	for _, start := range starts {
+		s.dist[start] = 0
+		queue.PushOrUpdate(start, heuristic(start), start)
+	}
+
+	for !queue.Empty() {
+		s.stats.frontier(queue.Len())
+		n, _, err := queue.Pop()
+		if err != nil {
+			return Result[N]{Stats: s.stats}, err
+		}
+		if isGoal(n) {
+			return s.result(n), nil
+		}
+
+		s.stats.Expanded += 1
+		for _, next := range g.Neighbours(n) {
+			// A node which was already expanded goes back in the queue if it's
+			// found more cheaply, which can happen when the heuristic isn't
+			// consistent
+			dist := s.dist[n] + g.Cost(n, next)
+			if best, seen := s.dist[next]; seen && best <= dist {
+				continue
+			}
+			s.dist[next] = dist
+			s.parent[next] = n
+			queue.PushOrUpdate(next, dist+heuristic(next), next)
+		}
+	}
+	return Result[N]{Stats: s.stats}, ErrNoPath
+}
@@ -0,0 +1,154 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/martin-nyaga/aoc-2022/util/geom"
+	"github.com/stretchr/testify/assert"
+)
+
+// maze is a grid graph where '#' is a wall and digits cost that much to
+// step onto
+type maze struct {
+	rows []string
+	goal geom.Point
+}
+
+func (m maze) at(p geom.Point) byte {
+	if p[1] < 0 || p[1] >= len(m.rows) || p[0] < 0 || p[0] >= len(m.rows[p[1]]) {
+		return '#'
+	}
+	return m.rows[p[1]][p[0]]
+}
+
+func (m maze) Neighbours(p geom.Point) []geom.Point {
+	result := make([]geom.Point, 0, 4)
+	for _, next := range p.Neighbours4() {
+		if m.at(next) != '#' {
+			result = append(result, next)
+		}
+	}
+	return result
+}
+
+func (m maze) Cost(from, to geom.Point) int {
+	if c := m.at(to); c >= '1' && c <= '9' {
+		return int(c - '0')
+	}
+	return 1
+}
+
+func (m maze) Heuristic(p geom.Point) int {
+	return geom.Manhattan(p, m.goal)
+}
+
+func (m maze) isGoal(p geom.Point) bool {
+	return p == m.goal
+}
+
+var example = maze{
+	rows: []string{
+		"....#",
+		".##9.",
+		".....",
+		"#.#..",
+	},
+	goal: geom.Point{4, 1},
+}
+
+func TestBFS(t *testing.T) {
+	result, err := BFS[geom.Point](example, []geom.Point{{0, 0}}, example.isGoal)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, result.Distance)
+	assert.Equal(t, []geom.Point{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {3, 1}, {4, 1}}, result.Path)
+	assert.Equal(t, example.goal, result.Goal)
+	assert.Greater(t, result.Stats.Expanded, 0)
+	assert.Greater(t, result.Stats.MaxFrontier, 0)
+}
+
+func TestDijkstraAvoidsExpensiveNodes(t *testing.T) {
+	result, err := Dijkstra[geom.Point](example, []geom.Point{{0, 0}}, example.isGoal)
+	assert.Nil(t, err)
+	// Round the bottom instead of through the 9
+	assert.Equal(t, 7, result.Distance)
+	assert.Equal(t, geom.Point{0, 0}, result.Path[0])
+	assert.Equal(t, geom.Point{4, 2}, result.Path[6])
+	assert.Equal(t, 8, len(result.Path))
+}
+
+func TestAStarMatchesDijkstra(t *testing.T) {
+	astar, err := AStar[geom.Point](example, []geom.Point{{0, 0}}, example.isGoal)
+	assert.Nil(t, err)
+	dijkstra, _ := Dijkstra[geom.Point](example, []geom.Point{{0, 0}}, example.isGoal)
+	assert.Equal(t, dijkstra.Distance, astar.Distance)
+	assert.LessOrEqual(t, astar.Stats.Expanded, dijkstra.Stats.Expanded)
+}
+
+func TestAStarReopensNodes(t *testing.T) {
+	// The heuristic never overestimates, but drops by more than the cost of
+	// A to B, so B is expanded the expensive way first
+	edges := map[string]map[string]int{
+		"S": {"A": 1, "B": 3},
+		"A": {"B": 1},
+		"B": {"G": 3},
+	}
+	heuristic := map[string]int{"A": 4}
+	g := Funcs[string]{
+		NeighboursFunc: func(n string) []string {
+			result := []string{}
+			for _, next := range []string{"A", "B", "G"} {
+				if _, exists := edges[n][next]; exists {
+					result = append(result, next)
+				}
+			}
+			return result
+		},
+		CostFunc:      func(from, to string) int { return edges[from][to] },
+		HeuristicFunc: func(n string) int { return heuristic[n] },
+	}
+	result, err := AStar[string](g, []string{"S"}, func(n string) bool { return n == "G" })
+	assert.Nil(t, err)
+	assert.Equal(t, 5, result.Distance)
+	assert.Equal(t, []string{"S", "A", "B", "G"}, result.Path)
+}
+
+func TestMultipleStarts(t *testing.T) {
+	starts := []geom.Point{{0, 0}, {3, 3}}
+	result, err := BFS[geom.Point](example, starts, example.isGoal)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, result.Distance)
+	assert.Equal(t, geom.Point{3, 3}, result.Path[0])
+
+	result, err = Dijkstra[geom.Point](example, starts, example.isGoal)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, result.Distance)
+}
+
+func TestStartIsGoal(t *testing.T) {
+	result, err := AStar[geom.Point](example, []geom.Point{example.goal}, example.isGoal)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, result.Distance)
+	assert.Equal(t, []geom.Point{example.goal}, result.Path)
+}
+
+func TestNoPath(t *testing.T) {
+	walledIn := maze{rows: []string{".#."}, goal: geom.Point{2, 0}}
+	_, err := BFS[geom.Point](walledIn, []geom.Point{{0, 0}}, walledIn.isGoal)
+	assert.Equal(t, ErrNoPath, err)
+	_, err = AStar[geom.Point](walledIn, []geom.Point{{0, 0}}, walledIn.isGoal)
+	assert.Equal(t, ErrNoPath, err)
+}
+
+func TestFuncs(t *testing.T) {
+	// A line of numbers where each leads to the next two
+	g := Funcs[int]{NeighboursFunc: func(n int) []int { return []int{n + 1, n + 2} }}
+	result, err := BFS[int](g, []int{0}, func(n int) bool { return n == 7 })
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result.Distance)
+	assert.Equal(t, 5, len(result.Path))
+
+	g.CostFunc = func(from, to int) int { return (to - from) * (to - from) }
+	result, err = Dijkstra[int](g, []int{0}, func(n int) bool { return n == 4 })
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result.Distance)
+}
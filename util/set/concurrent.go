@@ -0,0 +1,120 @@
+package set
+
+import (
+	"fmt"
+	"hash/maphash"
+	"sync"
+
+	"github.com/martin-nyaga/aoc-2022/util/hash"
+	"golang.org/x/exp/constraints"
+)
+
+const shards = 32
+
+type shard[T comparable] struct {
+	mu  sync.RWMutex
+	set Set[T]
+}
+
+// Concurrent is a set which several goroutines can share, e.g. as the
+// visited set of a parallel search. Elements are spread over shards with
+// their own locks, so workers rarely wait for each other.
+type Concurrent[T comparable] struct {
+	hash   func(T) uint64
+	shards [shards]shard[T]
+}
+
+// NewConcurrent makes a set which uses hash to pick the shard for an
+// element, e.g. HashString or HashInt
+func NewConcurrent[T comparable](hash func(T) uint64, elements ...T) *Concurrent[T] {
+	s := &Concurrent[T]{hash: hash}
+	for i := range s.shards {
+		s.shards[i].set = Set[T]{}
+	}
+	for _, el := range elements {
+		s.Add(el)
+	}
+	return s
+}
+
+var seed = maphash.MakeSeed()
+
+func HashString(s string) uint64 {
+	return maphash.String(seed, s)
+}
+
+func HashInt[T constraints.Integer](i T) uint64 {
+	// Mixed so consecutive ints land in different shards
+	return hash.Mix(uint64(i))
+}
+
+func (s *Concurrent[T]) shardFor(el T) *shard[T] {
+	return &s.shards[s.hash(el)%shards]
+}
+
+func (s *Concurrent[T]) Add(el T) {
+	s.AddIfAbsent(el)
+}
+
+// AddIfAbsent adds el, reporting whether it was new. Only one of several
+// goroutines adding the same element sees true, so that one can go on to
+// expand it.
+func (s *Concurrent[T]) AddIfAbsent(el T) bool {
+	sh := s.shardFor(el)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if sh.set.Has(el) {
+		return false
+	}
+	sh.set.Add(el)
+	return true
+}
+
+func (s *Concurrent[T]) Has(el T) bool {
+	sh := s.shardFor(el)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return sh.set.Has(el)
+}
+
+func (s *Concurrent[T]) Remove(el T) error {
+	sh := s.shardFor(el)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if !sh.set.Has(el) {
+		return fmt.Errorf("Set doesn't have %#v", el)
+	}
+	delete(sh.set, el)
+	return nil
+}
+
+// Len counts each shard in turn, so it's only exact when nothing is adding
+// or removing at the same time
+func (s *Concurrent[T]) Len() int {
+	total := 0
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.RLock()
+		total += sh.set.Len()
+		sh.mu.RUnlock()
+	}
+	return total
+}
+
+func (s *Concurrent[T]) Empty() bool {
+	return s.Len() == 0
+}
+
+// ToSet copies the elements into a plain Set, with the same caveat as Len
+func (s *Concurrent[T]) ToSet() Set[T] {
+	result := Set[T]{}
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.RLock()
+		for k := range sh.set {
+			result.Add(k)
+		}
+		sh.mu.RUnlock()
+	}
+	return result
+}
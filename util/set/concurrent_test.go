@@ -0,0 +1,85 @@
+package set
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrent(t *testing.T) {
+	s := NewConcurrent(HashString, "a", "b")
+	assert.Equal(t, 2, s.Len())
+	assert.True(t, s.Has("a"))
+	assert.False(t, s.AddIfAbsent("a"))
+	assert.True(t, s.AddIfAbsent("c"))
+	assert.Equal(t, 3, s.Len())
+
+	assert.Nil(t, s.Remove("a"))
+	assert.NotNil(t, s.Remove("a"))
+	assert.False(t, s.Has("a"))
+
+	plain := s.ToSet()
+	assert.Equal(t, []string{"b", "c"}, SortedSlice(&plain))
+
+	assert.True(t, NewConcurrent(HashInt[int]).Empty())
+}
+
+func TestConcurrentAddIfAbsentRace(t *testing.T) {
+	const workers = 8
+	const n = 2000
+	s := NewConcurrent(HashInt[int])
+	var added int64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Every worker tries every element, only one should win each
+			for i := 0; i < n; i++ {
+				if s.AddIfAbsent(i) {
+					atomic.AddInt64(&added, 1)
+				}
+				assert.True(t, s.Has(i))
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(n), added)
+	assert.Equal(t, n, s.Len())
+}
+
+func TestConcurrentMixedRace(t *testing.T) {
+	s := NewConcurrent(HashString)
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				s.Add(strconv.Itoa(w*1000 + i))
+			}
+		}(w)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				s.Remove(strconv.Itoa(w*1000 + i))
+				s.Len()
+			}
+		}(w)
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, s.Len(), 2000)
+}
+
+func BenchmarkConcurrentAddIfAbsent(b *testing.B) {
+	s := NewConcurrent(HashInt[int])
+	var next int64
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.AddIfAbsent(int(atomic.AddInt64(&next, 1) % 100000))
+		}
+	})
+}
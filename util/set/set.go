@@ -3,6 +3,9 @@ package set
 import (
 	"errors"
 	"fmt"
+	"sort"
+
+	"golang.org/x/exp/constraints"
 )
 
 type void struct{}
@@ -51,6 +54,93 @@ func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
 	return &intersection
 }
 
+// IntersectAll returns the elements found in every one of the sets
+func IntersectAll[T comparable](sets ...*Set[T]) *Set[T] {
+	if len(sets) == 0 {
+		return &Set[T]{}
+	}
+	result := sets[0].Clone()
+	for _, other := range sets[1:] {
+		result = result.Intersection(other)
+	}
+	return result
+}
+
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	union := s.Clone()
+	for k := range *other {
+		union.Add(k)
+	}
+	return union
+}
+
+// Difference returns the elements of s which aren't in other
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	return s.Filter(func(k T) bool { return !other.Has(k) })
+}
+
+// SymmetricDifference returns the elements in exactly one of the sets
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	return s.Difference(other).Union(other.Difference(s))
+}
+
+// IsSubset reports whether every element of s is in other
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for k := range *s {
+		if !other.Has(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset reports whether every element of other is in s
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+func (s *Set[T]) Clone() *Set[T] {
+	clone := make(Set[T], s.Len())
+	for k := range *s {
+		clone.Add(k)
+	}
+	return &clone
+}
+
+// Filter returns a new set of the elements for which fn returns true
+func (s *Set[T]) Filter(fn func(T) bool) *Set[T] {
+	result := Set[T]{}
+	for k := range *s {
+		if fn(k) {
+			result.Add(k)
+		}
+	}
+	return &result
+}
+
+// Map returns a new set of the results of calling fn on each element
+func Map[T comparable, U comparable](s *Set[T], fn func(T) U) *Set[U] {
+	result := make(Set[U], s.Len())
+	for k := range *s {
+		result.Add(fn(k))
+	}
+	return &result
+}
+
+// SortedSlice returns the elements in ascending order
+func SortedSlice[T constraints.Ordered](s *Set[T]) []T {
+	result := s.ToSlice()
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
 func (s *Set[T]) PopAny() (T, error) {
 	for k := range *s {
 		s.Remove(k)
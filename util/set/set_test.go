@@ -57,3 +57,77 @@ func TestPop(t *testing.T) {
 	_, err = set.PopAny()
 	assert.NotNil(t, err)
 }
+
+func TestIntersectAll(t *testing.T) {
+	a := NewSet([]int{1, 2, 3}...)
+	b := NewSet([]int{2, 3, 4}...)
+	c := NewSet([]int{3, 4, 5}...)
+
+	assert.Equal(t, []int{3}, SortedSlice(IntersectAll(&a, &b, &c)))
+	assert.Equal(t, []int{1, 2, 3}, SortedSlice(IntersectAll(&a)))
+	assert.True(t, IntersectAll[int]().Empty())
+
+	// The first set isn't modified
+	assert.Equal(t, 3, a.Len())
+}
+
+func TestUnion(t *testing.T) {
+	a := NewSet([]int{1, 2}...)
+	b := NewSet([]int{2, 3}...)
+	c := NewSet[int]()
+
+	assert.Equal(t, []int{1, 2, 3}, SortedSlice(a.Union(&b)))
+	assert.Equal(t, []int{1, 2}, SortedSlice(a.Union(&c)))
+	assert.Equal(t, 2, a.Len())
+}
+
+func TestDifference(t *testing.T) {
+	a := NewSet([]int{1, 2, 3}...)
+	b := NewSet([]int{2, 4}...)
+
+	assert.Equal(t, []int{1, 3}, SortedSlice(a.Difference(&b)))
+	assert.Equal(t, []int{4}, SortedSlice(b.Difference(&a)))
+	assert.Equal(t, []int{1, 3, 4}, SortedSlice(a.SymmetricDifference(&b)))
+	assert.True(t, a.SymmetricDifference(&a).Empty())
+}
+
+func TestSubsets(t *testing.T) {
+	a := NewSet([]int{1, 2}...)
+	b := NewSet([]int{1, 2, 3}...)
+	c := NewSet[int]()
+
+	assert.True(t, a.IsSubset(&b))
+	assert.False(t, b.IsSubset(&a))
+	assert.True(t, b.IsSuperset(&a))
+	assert.False(t, a.IsSuperset(&b))
+	assert.True(t, c.IsSubset(&a))
+	assert.True(t, a.IsSubset(&a))
+}
+
+func TestEqualAndClone(t *testing.T) {
+	a := NewSet([]int{1, 2}...)
+	b := NewSet([]int{2, 1}...)
+	c := NewSet([]int{1, 3}...)
+
+	assert.True(t, a.Equal(&b))
+	assert.False(t, a.Equal(&c))
+
+	clone := a.Clone()
+	assert.True(t, clone.Equal(&a))
+	clone.Add(3)
+	assert.False(t, clone.Equal(&a))
+	assert.Equal(t, 2, a.Len())
+}
+
+func TestFilterAndMap(t *testing.T) {
+	a := NewSet([]int{1, 2, 3, 4}...)
+
+	even := a.Filter(func(n int) bool { return n%2 == 0 })
+	assert.Equal(t, []int{2, 4}, SortedSlice(even))
+
+	halves := Map(&a, func(n int) int { return n / 2 })
+	assert.Equal(t, []int{0, 1, 2}, SortedSlice(halves))
+
+	strs := Map(&a, func(n int) string { return string(rune('a' + n)) })
+	assert.Equal(t, []string{"b", "c", "d", "e"}, SortedSlice(strs))
+}
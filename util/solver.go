@@ -0,0 +1,164 @@
+package util
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// Solver is implemented by each day's solution. Parse is called once with the
+// puzzle input, after which either part can be solved.
+type Solver interface {
+	Parse(r io.Reader) error
+	Part1() (Answer, error)
+	Part2() (Answer, error)
+}
+
+// ErrUnsolved is returned by solvers for parts they don't implement, e.g. the
+// days which have separate part1 and part2 solutions.
+var ErrUnsolved = errors.New("part not solved by this solver")
+
+type Registration struct {
+	Day     int
+	Variant string
+	New     func() Solver
+}
+
+// Name is the day and variant as it appears in the runner, e.g. "02/golf"
+func (r Registration) Name() string {
+	if r.Variant == "" {
+		return DayDir(r.Day)
+	}
+	return DayDir(r.Day) + "/" + r.Variant
+}
+
+var registry = make([]Registration, 0)
+
+// Register makes a solver available to the runner. It is meant to be called
+// from the init function of each day's package.
+func Register(day int, variant string, newSolver func() Solver) {
+	for _, r := range registry {
+		if r.Day == day && r.Variant == variant {
+			panic(fmt.Sprintf("Solver for %s registered twice", r.Name()))
+		}
+	}
+	registry = append(registry, Registration{Day: day, Variant: variant, New: newSolver})
+	sort.Slice(registry, func(i, j int) bool {
+		if registry[i].Day != registry[j].Day {
+			return registry[i].Day < registry[j].Day
+		}
+		return registry[i].Variant < registry[j].Variant
+	})
+}
+
+// Solvers returns every registered solver, ordered by day and variant
+func Solvers() []Registration {
+	result := make([]Registration, len(registry))
+	copy(result, registry)
+	return result
+}
+
+// SolversFor returns the registered solvers for a single day
+func SolversFor(day int) []Registration {
+	result := make([]Registration, 0)
+	for _, r := range registry {
+		if r.Day == day {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// DayDir is the directory holding a day's solution and inputs
+func DayDir(day int) string {
+	return fmt.Sprintf("%02d", day)
+}
+
+// Result is the answer to a single part of a day, as produced by Solve
+type Result struct {
+	Day       int
+	Variant   string
+	Part      int
+	Answer    Answer
+	Err       error
+	ParseTime time.Duration
+	SolveTime time.Duration
+}
+
+func (r Result) MarshalJSON() ([]byte, error) {
+	var errStr string
+	if r.Err != nil {
+		errStr = r.Err.Error()
+	}
+	var answer *Answer
+	if r.Answer.Kind != NoAnswer {
+		answer = &r.Answer
+	}
+	return json.Marshal(struct {
+		Day       int     `json:"day"`
+		Variant   string  `json:"variant,omitempty"`
+		Part      int     `json:"part"`
+		Answer    *Answer `json:"answer,omitempty"`
+		Err       string  `json:"error,omitempty"`
+		ParseTime int64   `json:"parse_ns"`
+		SolveTime int64   `json:"solve_ns"`
+	}{r.Day, r.Variant, r.Part, answer, errStr, r.ParseTime.Nanoseconds(), r.SolveTime.Nanoseconds()})
+}
+
+// Solve parses the input with a fresh solver and solves the given parts,
+// timing each step. Parts the solver doesn't implement are left out of the
+// results.
+func Solve(registration Registration, r io.Reader, parts ...int) []Result {
+	results := make([]Result, 0, len(parts))
+	result := Result{Day: registration.Day, Variant: registration.Variant}
+
+	solver := registration.New()
+	start := time.Now()
+	err := solver.Parse(r)
+	result.ParseTime = time.Since(start)
+
+	// Let parse errors point at the file they came from
+	var parseErr *ParseError
+	if named, ok := r.(interface{ Name() string }); ok && errors.As(err, &parseErr) && parseErr.File == "" {
+		parseErr.File = named.Name()
+	}
+
+	if err != nil {
+		for _, part := range parts {
+			result.Part = part
+			result.Err = err
+			results = append(results, result)
+		}
+		return results
+	}
+
+	for _, part := range parts {
+		partFn := solver.Part1
+		if part == 2 {
+			partFn = solver.Part2
+		}
+
+		start := time.Now()
+		answer, err := partFn()
+		if errors.Is(err, ErrUnsolved) {
+			continue
+		}
+		result.Part = part
+		result.Answer = answer
+		result.Err = err
+		result.SolveTime = time.Since(start)
+		results = append(results, result)
+	}
+	return results
+}
+
+// SameAnswer reports whether two results were solved with the same answer
+func SameAnswer(a, b Result) bool {
+	if a.Err != nil || b.Err != nil {
+		return false
+	}
+	return a.Answer.Equal(b.Answer)
+}